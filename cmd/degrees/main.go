@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Degrees of separation.
+// Reads a symbol graph from a file (see graph.SymbolGraph), then repeatedly
+// reads vertex names from standard input and prints a shortest path from the
+// source vertices to each of them. With several sources, the path starts at
+// the nearest one.
+// In a graph of performers and movies, such as movies.txt, the path
+// alternates between performers and movies, and the degrees of separation
+// (the "Kevin Bacon number") are half the length of the path.
+
+// the -s flag, which may be repeated
+type sourceNames []string
+
+func (s *sourceNames) String() string {
+	return strings.Join(*s, ", ")
+}
+
+func (s *sourceNames) Set(name string) error {
+	*s = append(*s, name)
+	return nil
+}
+
+var (
+	file, delimiter string
+	sources         sourceNames
+	bipartite       bool
+)
+
+func init() {
+	flag.StringVar(&file, "f", "", "file name (path)")
+	flag.StringVar(&delimiter, "d", "/", "delimiter between the vertex names")
+	flag.Var(&sources, "s", "source vertex name (may be repeated)")
+	flag.BoolVar(&bipartite, "bipartite", true, "the graph alternates between two kinds of vertices, as performers and movies: one degree is two edges")
+}
+
+// RUN
+// go run main.go -f ../../graphs/digraph/testdata/movies.txt.gz -s "Bacon, Kevin"
+// go run main.go -f ../../graphs/graph/testdata/routes.txt -d " " -s JFK -bipartite=false
+func main() {
+	flag.Parse()
+
+	if file == "" || len(sources) == 0 {
+		fmt.Println("Usage: degrees -f file [-d delimiter] -s source [-s source ...]")
+		os.Exit(1)
+	}
+
+	sg := graph.NewSymbolGraph(file, delimiter)
+	g := sg.Graph()
+
+	s := make([]int, len(sources))
+	for i, name := range sources {
+		if !sg.Contains(name) {
+			fmt.Println(name, "not in database.")
+			os.Exit(1)
+		}
+		s[i] = sg.Index(name)
+	}
+
+	bfs := graph.NewBreadthFirstPathsMulti(&g, s)
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		sink := strings.TrimSpace(scanner.Text())
+		if sink == "" {
+			continue
+		}
+		if !sg.Contains(sink) {
+			fmt.Println("   Not in database.")
+			continue
+		}
+
+		t := sg.Index(sink)
+		if !bfs.HasPathTo(t) {
+			fmt.Println("   Not connected")
+			continue
+		}
+		for _, name := range sg.Names(bfs.PathTo(t)) {
+			fmt.Println("  ", name)
+		}
+		degrees := bfs.DistTo(t)
+		if bipartite {
+			degrees /= 2
+		}
+		fmt.Println("Degrees of separation:", degrees)
+	}
+}
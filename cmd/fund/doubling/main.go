@@ -2,48 +2,54 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"strings"
 
-	"github.com/youngzhu/algs4-go/fund/xsum"
 	"github.com/youngzhu/algs4-go/testutil"
 )
 
+// Generates a sequence of random inputs, doubling the input size at each step,
+// and prints the running times of the chosen workload for each input size,
+// followed by the power law T(n) ~ a*n^b fitted to them.
+
 var (
-	rand  testutil.Random
-	ratio = flag.Bool("r", false, "doubling ratio test")
+	rand    testutil.Random
+	ratio   = flag.Bool("r", false, "doubling ratio test")
+	name    = flag.String("w", "threesum", "workload: "+strings.Join(workloadNames(), ", "))
+	start   = flag.Int("n", 250, "first input size")
+	maxN    = flag.Int("max", 0, "largest input size (0: no limit)")
+	maxTime = flag.Float64("t", 0, "stop after a trial longer than t seconds (0: no limit)")
+	warmup  = flag.Int("warmup", 0, "number of untimed runs before the first trial")
+	repeat  = flag.Int("repeat", 1, "timed runs per input size, the median is reported")
 )
 
 func init() {
 	rand = *testutil.NewRandom()
 }
 
+// RUN
+// go run . -w threesum -r -max 8000
 func main() {
 	flag.Parse()
 
-	if *ratio {
-		doublingRatio()
-	} else {
-		doublingTest()
+	w, ok := workloads[strings.ToLower(*name)]
+	if !ok {
+		fmt.Println("Invalid workload:", *name)
+		fmt.Println("Workloads:", strings.Join(workloadNames(), ", "))
+		os.Exit(1)
 	}
-}
 
-const (
-	min = -1000000
-	max = 1000000
-)
+	d := testutil.NewDoublingTest(w.run, w.generator)
+	d.Start = *start
+	d.Max = *maxN
+	d.MaxTime = *maxTime
+	d.Warmup = *warmup
+	d.Repeat = *repeat
 
-// Returns the amount of time to call ThreeSumCount() with n random
-// 6-digit integers
-func timeTrial(n int) float64 {
-	a := make([]int, n)
-	for i := 0; i < n; i++ {
-		a[i] = rand.UniformIntRange(min, max)
+	if *ratio {
+		d.DoublingRatio()
+	} else {
+		d.DoublingTest()
 	}
-	timer := testutil.NewStopwatch()
-
-	xsum.ThreeSumCount(a)
-
-	// xsum.TwoSumCount(a)
-	// xsum.TwoSumCountFast(a)
-
-	return timer.ElapsedTime()
 }
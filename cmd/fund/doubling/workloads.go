@@ -0,0 +1,67 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/youngzhu/algs4-go/fund/xsum"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+const (
+	min = -1000000
+	max = 1000000
+)
+
+// input shared by the workloads, filled in by the generators
+var a []int
+
+// n random 6-digit integers
+func randomInts(n int) {
+	a = make([]int, n)
+	for i := 0; i < n; i++ {
+		a[i] = rand.UniformIntRange(min, max)
+	}
+}
+
+// n distinct random 6-digit integers, TwoSumCountFast() and
+// ThreeSumCountFast() panic on duplicates
+func distinctInts(n int) {
+	if n > max-min {
+		panic("too many distinct integers")
+	}
+
+	seen := make(map[int]bool, n)
+	a = make([]int, 0, n)
+	for len(a) < n {
+		x := rand.UniformIntRange(min, max)
+		if !seen[x] {
+			seen[x] = true
+			a = append(a, x)
+		}
+	}
+}
+
+type workload struct {
+	run       testutil.Workload
+	generator testutil.Generator
+}
+
+var workloads = map[string]workload{
+	"threesum":          {func(n int) { xsum.ThreeSumCount(a) }, randomInts},
+	"threesumfast":      {func(n int) { xsum.ThreeSumCountFast(a) }, distinctInts},
+	"threesumquadratic": {func(n int) { xsum.ThreeSumCountQuadratic(a, 0) }, randomInts},
+	"twosum":            {func(n int) { xsum.TwoSumCount(a) }, randomInts},
+	"twosumfast":        {func(n int) { xsum.TwoSumCountFast(a) }, distinctInts},
+	"twosumhash":        {func(n int) { xsum.TwoSumCountHash(a, 0) }, randomInts},
+	"sort":              {func(n int) { sort.Ints(a) }, randomInts},
+}
+
+// names of the registered workloads, in alphabetical order
+func workloadNames() []string {
+	names := make([]string, 0, len(workloads))
+	for name := range workloads {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
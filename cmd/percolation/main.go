@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/youngzhu/algs4-go/fund/uf"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// Estimates the percolation threshold of an n-by-n grid by Monte Carlo
+// simulation: performs t independent trials, and prints the sample mean,
+// standard deviation and 95% confidence interval of the thresholds.
+// The seed is printed as well, run again with -seed to reproduce a result.
+
+var (
+	n, trials int
+	seed      int64
+	alg       string
+)
+
+var algs = map[string]func(n int) uf.UnionFind{
+	"uf":         func(n int) uf.UnionFind { return uf.NewUF(n) },
+	"quickfind":  func(n int) uf.UnionFind { return uf.NewQuickFind(n) },
+	"quickunion": func(n int) uf.UnionFind { return uf.NewQuickUnion(n) },
+	"weighted":   func(n int) uf.UnionFind { return uf.NewWeightedQuickUnion(n) },
+	"rollback":   func(n int) uf.UnionFind { return uf.NewRollbackUF(n) },
+	"concurrent": func(n int) uf.UnionFind { return uf.NewConcurrentUF(n) },
+}
+
+func init() {
+	flag.IntVar(&n, "n", 200, "grid size (n-by-n)")
+	flag.IntVar(&trials, "t", 100, "number of trials")
+	flag.Int64Var(&seed, "seed", 0, "random seed (0: use the current time)")
+	flag.StringVar(&alg, "uf", "uf", "union-find implementation: uf, quickfind, quickunion, weighted, rollback or concurrent")
+}
+
+// RUN
+// go run main.go -n 200 -t 100 -seed 2022
+func main() {
+	flag.Parse()
+
+	newUF, ok := algs[strings.ToLower(alg)]
+	if !ok {
+		fmt.Println("Invalid union-find implementation:", alg)
+		os.Exit(1)
+	}
+	if n <= 0 || trials <= 0 {
+		fmt.Println("n and t must be positive")
+		os.Exit(1)
+	}
+
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := testutil.NewRandom()
+	r.Seed(seed)
+
+	timer := testutil.NewStopwatch()
+	stats := uf.NewPercolationStats(n, trials, r, newUF)
+	elapsed := timer.ElapsedTime()
+
+	fmt.Printf("seed                    = %d\n", seed)
+	fmt.Printf("mean                    = %f\n", stats.Mean())
+	fmt.Printf("stddev                  = %f\n", stats.Stddev())
+	fmt.Printf("95%% confidence interval = [%f, %f]\n", stats.ConfidenceLo(), stats.ConfidenceHi())
+	fmt.Printf("elapsed time            = %.3fs\n", elapsed)
+}
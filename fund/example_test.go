@@ -159,6 +159,22 @@ func ExampleBag_String() {
 	// [4, 3, 2, 1]
 }
 
+func ExampleList() {
+	list := fund.NewList()
+	for _, v := range []int{1, 2, 3, 2, 4} {
+		list.Add(v)
+	}
+	fmt.Println(list)
+
+	list.Remove(2)
+	list.Replace(1, 5)
+	fmt.Println(list, list.Size(), list.Contains(2), list.Remove(6))
+
+	// Output:
+	// [4, 2, 3, 2, 1]
+	// [4, 3, 2, 5] 4 true false
+}
+
 // Read a sequence of numbers and computes their mean and standard deviation
 func ExampleBag_stats() {
 	bag := fund.NewBag()
@@ -0,0 +1,100 @@
+package fund
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lists.
+// A list is a bag that also supports removing and replacing items, for
+// collections that change after they are built, such as the adjacency lists
+// of a graph whose edges can be deleted. Items are added at the front, so
+// iterating gives the items in the same order as a Bag with the same items
+// added. Removing or replacing an item searches the list: linear time.
+
+// List implemented using a singly linked list
+type List struct {
+	first *Node // beginning of list
+	size  int   // number of elements in list
+}
+
+func NewList() *List {
+	return &List{}
+}
+
+// Add adds the item to this list
+func (l *List) Add(item Item) {
+	l.first = newNode(item, l.first)
+	l.size++
+}
+
+// Remove removes the first occurrence of the item from this list.
+// Returns false if the item is not in the list.
+func (l *List) Remove(item Item) bool {
+	return l.RemoveFunc(func(x Item) bool { return x == item })
+}
+
+// RemoveFunc removes the first item for which match returns true.
+// Returns false if there is no such item.
+func (l *List) RemoveFunc(match func(item Item) bool) bool {
+	for p := &l.first; *p != nil; p = &(*p).next {
+		if match((*p).item) {
+			*p = (*p).next
+			l.size--
+			return true
+		}
+	}
+	return false
+}
+
+// Replace replaces the first occurrence of old with item, in place.
+// Returns false if old is not in the list.
+func (l *List) Replace(old, item Item) bool {
+	for cur := l.first; cur != nil; cur = cur.next {
+		if cur.item == old {
+			cur.item = item
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if the item is in this list
+func (l *List) Contains(item Item) bool {
+	for cur := l.first; cur != nil; cur = cur.next {
+		if cur.item == item {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *List) Iterator() Iterator {
+	items := make([]interface{}, l.size)
+
+	for i, cur := 0, l.first; i < l.size; i, cur = i+1, cur.next {
+		items[i] = cur.item
+	}
+
+	return items
+}
+
+// IsEmpty returns true if this list is empty
+func (l *List) IsEmpty() bool {
+	return l.first == nil
+}
+
+// Size returns the number of items in this list
+func (l *List) Size() int {
+	return l.size
+}
+
+func (l *List) String() string {
+	var ss []string
+
+	for _, v := range l.Iterator() {
+		ss = append(ss, fmt.Sprint(v))
+	}
+
+	return "[" + strings.Join(ss, ", ") + "]"
+}
@@ -1,6 +1,7 @@
 package uf_test
 
 import (
+	"sync"
 	"testing"
 
 	. "github.com/youngzhu/algs4-go/fund/uf"
@@ -105,6 +106,36 @@ func BenchmarkWeightedQuickUnionUF(b *testing.B) {
 	}
 }
 
+func BenchmarkRollbackUF(b *testing.B) {
+	b.ReportAllocs()
+	if testdata == nil {
+		b.StopTimer()
+		dataInit()
+		b.StartTimer()
+	}
+
+	unionFind := NewRollbackUF(testdata.n)
+
+	for i := 0; i < b.N; i++ {
+		benchUF(unionFind, testdata.pairs)
+	}
+}
+
+func BenchmarkConcurrentUF(b *testing.B) {
+	b.ReportAllocs()
+	if testdata == nil {
+		b.StopTimer()
+		dataInit()
+		b.StartTimer()
+	}
+
+	unionFind := NewConcurrentUF(testdata.n)
+
+	for i := 0; i < b.N; i++ {
+		benchUF(unionFind, testdata.pairs)
+	}
+}
+
 /* Run Parallel */
 
 func BenchmarkUFParallel(b *testing.B) {
@@ -171,6 +202,86 @@ func BenchmarkWeightedQuickUnionUFParallel(b *testing.B) {
 	})
 }
 
+func BenchmarkRollbackUFParallel(b *testing.B) {
+	b.ReportAllocs()
+	if testdata == nil {
+		b.StopTimer()
+		dataInit()
+		b.StartTimer()
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		unionFind := NewRollbackUF(testdata.n)
+		for pb.Next() {
+			benchUF(unionFind, testdata.pairs)
+		}
+	})
+}
+
+/* Run Parallel, one union-find shared by all goroutines */
+
+// lockedUF guards a UF with a mutex, the simple way to share it
+type lockedUF struct {
+	mu sync.Mutex
+	uf *UF
+}
+
+func (l *lockedUF) Union(p, q int) {
+	l.mu.Lock()
+	l.uf.Union(p, q)
+	l.mu.Unlock()
+}
+
+func (l *lockedUF) Find(p int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.uf.Find(p)
+}
+
+func (l *lockedUF) Connected(p, q int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.uf.Connected(p, q)
+}
+
+func (l *lockedUF) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.uf.Count()
+}
+
+func BenchmarkLockedUFSharedParallel(b *testing.B) {
+	b.ReportAllocs()
+	if testdata == nil {
+		b.StopTimer()
+		dataInit()
+		b.StartTimer()
+	}
+
+	unionFind := &lockedUF{uf: NewUF(testdata.n)}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			benchUF(unionFind, testdata.pairs)
+		}
+	})
+}
+
+func BenchmarkConcurrentUFSharedParallel(b *testing.B) {
+	b.ReportAllocs()
+	if testdata == nil {
+		b.StopTimer()
+		dataInit()
+		b.StartTimer()
+	}
+
+	unionFind := NewConcurrentUF(testdata.n)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			benchUF(unionFind, testdata.pairs)
+		}
+	})
+}
+
 // BenchmarkUF
 // BenchmarkUF-4                                      56833             20741 ns/op               0 B/op          0 allocs/op
 // BenchmarkQuickFindUF
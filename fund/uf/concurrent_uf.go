@@ -0,0 +1,133 @@
+package uf
+
+import "sync/atomic"
+
+// ConcurrentUF is a lock-free union-find data structure in the style of
+// Anderson and Woll. It is safe for concurrent use by multiple goroutines.
+// Each element is a single machine word that packs its parent together with
+// its rank, so that a root can be linked (or its rank raised) with one
+// compare-and-swap. A CAS that fails means another goroutine changed the root
+// in the meantime; the operation simply starts over. Roots are linked by
+// (rank, index) order, which never changes direction once observed, so
+// concurrent unions can not form a cycle. Find() uses path halving, again
+// with CAS, and only ever touches non-root elements.
+type ConcurrentUF struct {
+	record []uint64 // record[i]: rank of i (high byte) and parent of i
+	count  int64    // number of components
+}
+
+const (
+	rankShift  = 56
+	parentMask = 1<<rankShift - 1
+)
+
+func pack(parent int, rank uint64) uint64 {
+	return rank<<rankShift | uint64(parent)
+}
+
+func parentOf(rec uint64) int {
+	return int(rec & parentMask)
+}
+
+func rankOf(rec uint64) uint64 {
+	return rec >> rankShift
+}
+
+// NewConcurrentUF returns an empty union-find data structure with n elements (0...n-1)
+// Initially, each element is in its own set.
+func NewConcurrentUF(n int) *ConcurrentUF {
+	if n < 0 || n > parentMask {
+		panic("n out of range")
+	}
+
+	record := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		record[i] = pack(i, 0)
+	}
+
+	return &ConcurrentUF{record, int64(n)}
+}
+
+// Union merges the set containing element p with the set contain element q
+func (u *ConcurrentUF) Union(p, q int) {
+	for {
+		rootP := u.Find(p)
+		rootQ := u.Find(q)
+		if rootP == rootQ {
+			return
+		}
+
+		recP := atomic.LoadUint64(&u.record[rootP])
+		recQ := atomic.LoadUint64(&u.record[rootQ])
+		if parentOf(recP) != rootP || parentOf(recQ) != rootQ {
+			continue // one of them is no longer a root
+		}
+
+		// make the root that is smaller in (rank, index) order point to the other
+		if rankOf(recP) > rankOf(recQ) || (rankOf(recP) == rankOf(recQ) && rootP > rootQ) {
+			rootP, rootQ = rootQ, rootP
+			recP, recQ = recQ, recP
+		}
+
+		if !atomic.CompareAndSwapUint64(&u.record[rootP], recP, pack(rootQ, rankOf(recP))) {
+			continue
+		}
+		if rankOf(recP) == rankOf(recQ) {
+			// rank is only a balancing hint, losing this race is harmless
+			atomic.CompareAndSwapUint64(&u.record[rootQ], recQ, pack(rootQ, rankOf(recQ)+1))
+		}
+
+		atomic.AddInt64(&u.count, -1)
+		return
+	}
+}
+
+// Find returns the canonical element of the set containing element p.
+// When other goroutines are running unions, the result may already be stale
+// by the time it is returned.
+func (u *ConcurrentUF) Find(p int) int {
+	u.validate(p)
+	for {
+		rec := atomic.LoadUint64(&u.record[p])
+		parent := parentOf(rec)
+		if parent == p {
+			return p
+		}
+
+		grand := parentOf(atomic.LoadUint64(&u.record[parent]))
+		if grand != parent {
+			// path halving
+			atomic.CompareAndSwapUint64(&u.record[p], rec, pack(grand, rankOf(rec)))
+		}
+		p = grand
+	}
+}
+
+// Count returns the number of sets
+func (u *ConcurrentUF) Count() int {
+	return int(atomic.LoadInt64(&u.count))
+}
+
+// Connected returns true if the two elements are in the same set.
+// The answer is consistent with some point in time during the call.
+func (u *ConcurrentUF) Connected(p, q int) bool {
+	for {
+		rootP := u.Find(p)
+		rootQ := u.Find(q)
+		if rootP == rootQ {
+			return true
+		}
+		// still apart if rootP is still a root
+		if parentOf(atomic.LoadUint64(&u.record[rootP])) == rootP {
+			return false
+		}
+	}
+}
+
+// validate that p is a valid index
+func (u *ConcurrentUF) validate(p int) {
+	n := len(u.record)
+	if p < 0 || p >= n {
+		panic("invalid index")
+	}
+}
@@ -0,0 +1,90 @@
+package uf_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	. "github.com/youngzhu/algs4-go/fund/uf"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// go test -race -run="ConcurrentUF"
+
+// unions the pairs from several goroutines at once and compares the result
+// with the sequential UF
+func TestConcurrentUF(t *testing.T) {
+	if testdata == nil {
+		dataInit()
+	}
+
+	n := testdata.n
+	pairs := append([]pair{}, testdata.pairs...)
+
+	// add some random pairs so that there are contended unions
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	for i := 0; i < 4*n; i++ {
+		pairs = append(pairs, pair{r.UniformIntN(n), r.UniformIntN(n)})
+	}
+
+	want := NewUF(n)
+	for _, pq := range pairs[:len(testdata.pairs)] {
+		want.Union(pq.p, pq.q)
+	}
+
+	got := NewConcurrentUF(n)
+	workers := runtime.GOMAXPROCS(0) * 2
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// every worker processes every pair, starting at a different offset
+			m := len(testdata.pairs)
+			for i := 0; i < m; i++ {
+				pq := pairs[(i+w*m/workers)%m]
+				got.Union(pq.p, pq.q)
+				got.Connected(pq.p, pq.q)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if got.Count() != want.Count() {
+		t.Fatalf("count: got %d; want %d", got.Count(), want.Count())
+	}
+	for p := 0; p < n; p++ {
+		if !got.Connected(p, want.Find(p)) {
+			t.Fatalf("%d and %d should be connected", p, want.Find(p))
+		}
+	}
+
+	// now with the random pairs, concurrently and sequentially
+	for _, pq := range pairs[len(testdata.pairs):] {
+		want.Union(pq.p, pq.q)
+	}
+	random := pairs[len(testdata.pairs):]
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(random); i += workers {
+				got.Union(random[i].p, random[i].q)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if got.Count() != want.Count() {
+		t.Fatalf("count: got %d; want %d", got.Count(), want.Count())
+	}
+	for p := 0; p < n; p++ {
+		for q := p + 1; q < n; q += 7 {
+			if got.Connected(p, q) != want.Connected(p, q) {
+				t.Fatalf("connected(%d, %d): got %v; want %v",
+					p, q, got.Connected(p, q), want.Connected(p, q))
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package uf_test
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/fund/uf"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func printGrid(p *uf.Percolation, n int) {
+	for row := 1; row <= n; row++ {
+		for col := 1; col <= n; col++ {
+			switch {
+			case p.IsFull(row, col):
+				fmt.Print("~")
+			case p.IsOpen(row, col):
+				fmt.Print("o")
+			default:
+				fmt.Print("#")
+			}
+		}
+		fmt.Println()
+	}
+}
+
+func ExamplePercolation() {
+	n := 4
+	p := uf.NewPercolation(n, nil)
+
+	p.Open(4, 4) // open site in the bottom row, not full
+	p.Open(1, 2)
+	p.Open(2, 2)
+	p.Open(3, 2)
+	p.Open(3, 3)
+	fmt.Println(p.NumberOfOpenSites(), p.Percolates())
+
+	p.Open(4, 3)
+	fmt.Println(p.NumberOfOpenSites(), p.Percolates())
+
+	// no backwash: (4, 1) is in the bottom row of a percolating system,
+	// but it is not connected to the top
+	p.Open(4, 1)
+	printGrid(p, n)
+	fmt.Println(p.IsFull(4, 4), p.IsFull(4, 1))
+
+	// Output:
+	// 5 false
+	// 6 true
+	// #~##
+	// #~##
+	// #~~#
+	// o#~~
+	// true false
+}
+
+func ExamplePercolation_backwash() {
+	newUF := func(n int) uf.UnionFind { return uf.NewWeightedQuickUnion(n) }
+	p := uf.NewPercolation(3, newUF)
+
+	p.Open(1, 1)
+	p.Open(2, 1)
+	p.Open(3, 1)
+	p.Open(3, 3)
+	printGrid(p, 3)
+	fmt.Println(p.Percolates())
+
+	// Output:
+	// ~##
+	// ~##
+	// ~#o
+	// true
+}
+
+func ExamplePercolationStats() {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	stats := uf.NewPercolationStats(50, 100, r, nil)
+
+	fmt.Printf("mean                    = %.4f\n", stats.Mean())
+	fmt.Printf("stddev                  = %.4f\n", stats.Stddev())
+	fmt.Printf("95%% confidence interval = [%.4f, %.4f]\n", stats.ConfidenceLo(), stats.ConfidenceHi())
+
+	// Output:
+	// mean                    = 0.5877
+	// stddev                  = 0.0232
+	// 95% confidence interval = [0.5832, 0.5923]
+}
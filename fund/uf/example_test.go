@@ -2,6 +2,7 @@ package uf_test
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/youngzhu/algs4-go/fund/uf"
 	"github.com/youngzhu/algs4-go/testutil"
@@ -138,3 +139,133 @@ func ExampleWeightedQuickUnionUF() {
 	// 6 1
 	// 2 components
 }
+
+func ExampleRollbackUF() {
+
+	in := testutil.NewInReadWords("testdata/tinyUF.txt")
+
+	n := in.ReadInt()
+	unionFind := uf.NewRollbackUF(n)
+
+	for !in.IsEmpty() {
+		p := in.ReadInt()
+		q := in.ReadInt()
+
+		if unionFind.Find(p) == unionFind.Find(q) {
+			continue
+		}
+
+		unionFind.Union(p, q)
+		fmt.Printf("%d %d\n", p, q)
+	}
+
+	fmt.Printf("%d components", unionFind.Count())
+
+	// Output:
+	// 4 3
+	// 3 8
+	// 6 5
+	// 9 4
+	// 2 1
+	// 5 0
+	// 7 2
+	// 6 1
+	// 2 components
+}
+
+func ExampleRollbackUF_Rollback() {
+	unionFind := uf.NewRollbackUF(10)
+
+	unionFind.Union(4, 3)
+	unionFind.Union(3, 8)
+	snapshot := unionFind.Snapshot()
+
+	unionFind.Union(6, 5)
+	unionFind.Union(9, 4)
+	unionFind.Union(5, 4)
+	fmt.Printf("%d components, size of 4: %d\n", unionFind.Count(), unionFind.Size(4))
+
+	unionFind.Rollback(snapshot)
+	fmt.Printf("%d components, size of 4: %d\n", unionFind.Count(), unionFind.Size(4))
+	fmt.Println(unionFind.Connected(4, 9), unionFind.Connected(4, 8))
+
+	// Output:
+	// 5 components, size of 4: 6
+	// 8 components, size of 4: 3
+	// false true
+}
+
+func ExampleUF_Members() {
+	in := testutil.NewInReadWords("testdata/tinyUF.txt")
+
+	n := in.ReadInt()
+	unionFind := uf.NewUF(n)
+
+	for !in.IsEmpty() {
+		unionFind.Union(in.ReadInt(), in.ReadInt())
+	}
+
+	for _, p := range []int{0, 3} {
+		members := unionFind.Members(p)
+		sort.Ints(members)
+		fmt.Printf("%d: size %d %v\n", p, unionFind.Size(p), members)
+	}
+
+	// Output:
+	// 0: size 6 [0 1 2 5 6 7]
+	// 3: size 4 [3 4 8 9]
+}
+
+func ExampleWeightedQuickUnion_Members() {
+	in := testutil.NewInReadWords("testdata/tinyUF.txt")
+
+	n := in.ReadInt()
+	unionFind := uf.NewWeightedQuickUnion(n)
+
+	for !in.IsEmpty() {
+		unionFind.Union(in.ReadInt(), in.ReadInt())
+	}
+
+	for _, p := range []int{0, 3} {
+		members := unionFind.Members(p)
+		sort.Ints(members)
+		fmt.Printf("%d: size %d %v\n", p, unionFind.Size(p), members)
+	}
+
+	// Output:
+	// 0: size 6 [0 1 2 5 6 7]
+	// 3: size 4 [3 4 8 9]
+}
+
+func ExampleConcurrentUF() {
+
+	in := testutil.NewInReadWords("testdata/tinyUF.txt")
+
+	n := in.ReadInt()
+	unionFind := uf.NewConcurrentUF(n)
+
+	for !in.IsEmpty() {
+		p := in.ReadInt()
+		q := in.ReadInt()
+
+		if unionFind.Find(p) == unionFind.Find(q) {
+			continue
+		}
+
+		unionFind.Union(p, q)
+		fmt.Printf("%d %d\n", p, q)
+	}
+
+	fmt.Printf("%d components", unionFind.Count())
+
+	// Output:
+	// 4 3
+	// 3 8
+	// 6 5
+	// 9 4
+	// 2 1
+	// 5 0
+	// 7 2
+	// 6 1
+	// 2 components
+}
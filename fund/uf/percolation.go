@@ -0,0 +1,116 @@
+package uf
+
+// Percolation.
+// Given a composite systems comprised of randomly distributed insulating and
+// metallic materials: what fraction of the materials need to be metallic so
+// that the composite system is an electrical conductor? We model such a system
+// as an n-by-n grid of sites. Each site is either open or blocked. A full site
+// is an open site that can be connected to an open site in the top row via a
+// chain of neighboring (left, right, up, down) open sites. We say the system
+// percolates if there is a full site in the bottom row.
+//
+// Two virtual sites are added to the union-find: a top site connected to every
+// open site of the top row and a bottom site connected to every open site of
+// the bottom row, so that the system percolates if and only if the virtual
+// top and bottom sites are connected. The bottom site alone would introduce
+// "backwash": once the system percolates, every open site connected to the
+// bottom row would look full. So a second union-find without the virtual
+// bottom site is used to answer IsFull().
+//
+// Rows and columns are indexed from 1 to n, like in the original assignment.
+type Percolation struct {
+	n       int
+	open    []bool    // open[i]: is site i open?
+	openNum int       // number of open sites
+	uf      UnionFind // sites + virtual top + virtual bottom
+	ufTop   UnionFind // sites + virtual top, free of backwash
+	top     int       // index of the virtual top site
+	bottom  int       // index of the virtual bottom site
+}
+
+// NewPercolation creates an n-by-n grid, with all sites initially blocked.
+// newUF is used to create the underlying union-find data structures, if it is
+// nil a UF is used.
+func NewPercolation(n int, newUF func(n int) UnionFind) *Percolation {
+	if n <= 0 {
+		panic("n must be positive")
+	}
+	if newUF == nil {
+		newUF = func(n int) UnionFind { return NewUF(n) }
+	}
+
+	sites := n * n
+	return &Percolation{
+		n:      n,
+		open:   make([]bool, sites),
+		uf:     newUF(sites + 2),
+		ufTop:  newUF(sites + 1),
+		top:    sites,
+		bottom: sites + 1,
+	}
+}
+
+// Open opens the site (row, col) if it is not open already
+func (p *Percolation) Open(row, col int) {
+	i := p.index(row, col)
+	if p.open[i] {
+		return
+	}
+	p.open[i] = true
+	p.openNum++
+
+	if row == 1 {
+		p.uf.Union(i, p.top)
+		p.ufTop.Union(i, p.top)
+	}
+	if row == p.n {
+		p.uf.Union(i, p.bottom)
+	}
+
+	// connect to the open neighbors
+	p.connect(i, row-1, col)
+	p.connect(i, row+1, col)
+	p.connect(i, row, col-1)
+	p.connect(i, row, col+1)
+}
+
+func (p *Percolation) connect(i, row, col int) {
+	if row < 1 || row > p.n || col < 1 || col > p.n {
+		return
+	}
+	j := p.index(row, col)
+	if p.open[j] {
+		p.uf.Union(i, j)
+		p.ufTop.Union(i, j)
+	}
+}
+
+// IsOpen returns true if the site (row, col) is open
+func (p *Percolation) IsOpen(row, col int) bool {
+	return p.open[p.index(row, col)]
+}
+
+// IsFull returns true if the site (row, col) is connected to the top row
+// through open sites
+func (p *Percolation) IsFull(row, col int) bool {
+	i := p.index(row, col)
+	return p.open[i] && p.ufTop.Connected(i, p.top)
+}
+
+// NumberOfOpenSites returns the number of open sites
+func (p *Percolation) NumberOfOpenSites() int {
+	return p.openNum
+}
+
+// Percolates returns true if the system percolates
+func (p *Percolation) Percolates() bool {
+	return p.uf.Connected(p.top, p.bottom)
+}
+
+// maps (row, col) to a site index in [0, n*n)
+func (p *Percolation) index(row, col int) int {
+	if row < 1 || row > p.n || col < 1 || col > p.n {
+		panic("invalid site")
+	}
+	return (row-1)*p.n + (col - 1)
+}
@@ -0,0 +1,91 @@
+package uf
+
+import (
+	"math"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// Monte Carlo simulation to estimate the percolation threshold.
+// For each of T independent trials:
+//  1. Initialize all sites to be blocked.
+//  2. Repeat the following until the system percolates: choose a site uniformly
+//     at random among all blocked sites and open it.
+//  3. The fraction of sites that are opened when the system percolates provides
+//     an estimate of the percolation threshold.
+//
+// The sample mean and standard deviation of the T estimates give the threshold
+// and a 95% confidence interval for it.
+type PercolationStats struct {
+	thresholds []float64 // thresholds[t]: fraction of open sites in trial t
+}
+
+const confidence95 = 1.96
+
+// NewPercolationStats performs trials independent experiments on an n-by-n grid.
+// Sites are chosen with r, so the same seed gives the same results. newUF is
+// passed on to NewPercolation().
+func NewPercolationStats(n, trials int, r *testutil.Random, newUF func(n int) UnionFind) *PercolationStats {
+	if n <= 0 || trials <= 0 {
+		panic("n and trials must be positive")
+	}
+
+	sites := make([]int, n*n)
+	thresholds := make([]float64, trials)
+	for t := 0; t < trials; t++ {
+		for i := range sites {
+			sites[i] = i
+		}
+		// Knuth shuffle, then open the sites in that order
+		for i := range sites {
+			j := r.UniformIntRange(i, len(sites))
+			sites[i], sites[j] = sites[j], sites[i]
+		}
+
+		p := NewPercolation(n, newUF)
+		for _, i := range sites {
+			p.Open(i/n+1, i%n+1)
+			if p.Percolates() {
+				break
+			}
+		}
+		thresholds[t] = float64(p.NumberOfOpenSites()) / float64(n*n)
+	}
+
+	return &PercolationStats{thresholds}
+}
+
+// Mean returns the sample mean of percolation threshold
+func (s *PercolationStats) Mean() float64 {
+	sum := 0.0
+	for _, x := range s.thresholds {
+		sum += x
+	}
+	return sum / float64(len(s.thresholds))
+}
+
+// Stddev returns the sample standard deviation of percolation threshold
+// (NaN with a single trial)
+func (s *PercolationStats) Stddev() float64 {
+	t := len(s.thresholds)
+	if t == 1 {
+		return math.NaN()
+	}
+
+	mean := s.Mean()
+	sum := 0.0
+	for _, x := range s.thresholds {
+		sum += (x - mean) * (x - mean)
+	}
+	return math.Sqrt(sum / float64(t-1))
+}
+
+// ConfidenceLo returns the low endpoint of the 95% confidence interval
+func (s *PercolationStats) ConfidenceLo() float64 {
+	return s.Mean() - confidence95*s.Stddev()/math.Sqrt(float64(len(s.thresholds)))
+}
+
+// ConfidenceHi returns the high endpoint of the 95% confidence interval
+func (s *PercolationStats) ConfidenceHi() float64 {
+	return s.Mean() + confidence95*s.Stddev()/math.Sqrt(float64(len(s.thresholds)))
+}
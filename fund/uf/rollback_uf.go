@@ -0,0 +1,132 @@
+package uf
+
+// RollbackUF is a union-find data structure that can undo unions.
+// It uses union by rank but no path compression, so that every Union()
+// changes only a constant number of array entries. Those changes are
+// recorded on a history stack, and Rollback() pops and reverts them in
+// reverse order. Find() therefore takes logarithmic time, but the structure
+// can go back to any earlier state, which is what offline dynamic
+// connectivity and some Kruskal variants need.
+type RollbackUF struct {
+	parent  []int    // parent[i]: parent of i
+	rank    []byte   // rank[i]: rank of subtree rooted at i (never more than 31)
+	size    []int    // size[i]: number of elements in subtree rooted at i
+	next    []int    // next[i]: next element in the (circular) member list of i's set
+	count   int      // number of components
+	history []change // unions performed, most recent last
+}
+
+// change records a union so that it can be undone
+type change struct {
+	child, root int  // child was linked under root
+	rankUp      bool // rank[root] was incremented
+}
+
+// NewRollbackUF returns an empty union-find data structure with n elements (0...n-1)
+// Initially, each element is in its own set.
+func NewRollbackUF(n int) *RollbackUF {
+	if n < 0 {
+		panic("n must be positive")
+	}
+
+	parent := make([]int, n)
+	rank := make([]byte, n)
+	size := make([]int, n)
+	next := make([]int, n)
+	for i := 0; i < n; i++ {
+		parent[i] = i
+		size[i] = 1
+		next[i] = i
+	}
+
+	return &RollbackUF{parent: parent, rank: rank, size: size, next: next, count: n}
+}
+
+// Union merges the set containing element p with the set contain element q
+func (u *RollbackUF) Union(p, q int) {
+	rootP := u.Find(p)
+	rootQ := u.Find(q)
+	if rootP == rootQ {
+		return
+	}
+
+	// make root of smaller rank point to root of larger rank
+	if u.rank[rootP] > u.rank[rootQ] {
+		rootP, rootQ = rootQ, rootP
+	}
+	rankUp := u.rank[rootP] == u.rank[rootQ]
+
+	u.parent[rootP] = rootQ
+	u.size[rootQ] += u.size[rootP]
+	if rankUp {
+		u.rank[rootQ]++
+	}
+	u.next[rootP], u.next[rootQ] = u.next[rootQ], u.next[rootP]
+	u.count--
+
+	u.history = append(u.history, change{rootP, rootQ, rankUp})
+}
+
+// Find returns the canonical element of the set containing element p
+func (u *RollbackUF) Find(p int) int {
+	u.validate(p)
+	for p != u.parent[p] {
+		p = u.parent[p]
+	}
+	return p
+}
+
+// Count returns the number of sets
+func (u *RollbackUF) Count() int {
+	return u.count
+}
+
+// Connected returns true if the two elements are in the same set
+func (u *RollbackUF) Connected(p, q int) bool {
+	return u.Find(p) == u.Find(q)
+}
+
+// Size returns the number of elements in the set containing element p
+func (u *RollbackUF) Size(p int) int {
+	return u.size[u.Find(p)]
+}
+
+// Members returns the elements in the set containing element p
+func (u *RollbackUF) Members(p int) []int {
+	u.validate(p)
+	return members(u.next, p)
+}
+
+// Snapshot returns a token for the current state, to be passed to Rollback()
+func (u *RollbackUF) Snapshot() int {
+	return len(u.history)
+}
+
+// Rollback undoes every union performed since the given snapshot was taken
+func (u *RollbackUF) Rollback(snapshot int) {
+	if snapshot < 0 || snapshot > len(u.history) {
+		panic("invalid snapshot")
+	}
+
+	for len(u.history) > snapshot {
+		c := u.history[len(u.history)-1]
+		u.history = u.history[:len(u.history)-1]
+
+		// swapping the successors again splits the member lists
+		u.next[c.child], u.next[c.root] = u.next[c.root], u.next[c.child]
+		if c.rankUp {
+			u.rank[c.root]--
+		}
+		u.size[c.root] -= u.size[c.child]
+		u.parent[c.child] = c.child
+		u.count++
+	}
+}
+
+// validate that p is a valid index
+func (u *RollbackUF) validate(p int) {
+	n := len(u.parent)
+	if p < 0 || p >= n {
+		panic("invalid index")
+	}
+}
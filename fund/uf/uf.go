@@ -10,6 +10,8 @@ type UF struct {
 	parent []int  // parent[i]: parent of i
 	rank   []byte // rank[i]: rank of subtree rooted at i (never more than 31)
 	count  int    // number of components
+	size   []int  // size[i]: number of elements in subtree rooted at i
+	next   []int  // next[i]: next element in the (circular) member list of i's set
 }
 
 // NewUF returns an empty union-find data structure with n elements (0...n-1)
@@ -21,12 +23,16 @@ func NewUF(n int) *UF {
 
 	parent := make([]int, n)
 	rank := make([]byte, n)
+	size := make([]int, n)
+	next := make([]int, n)
 	for i := 0; i < n; i++ {
 		parent[i] = i
 		rank[i] = 0
+		size[i] = 1
+		next[i] = i
 	}
 
-	return &UF{parent, rank, n}
+	return &UF{parent, rank, n, size, next}
 }
 
 // Union merges the set containing element p with the set contain element q
@@ -40,13 +46,19 @@ func (u *UF) Union(p, q int) {
 	// make root of smaller rank point to root of larger rank
 	if u.rank[rootP] < u.rank[rootQ] {
 		u.parent[rootP] = rootQ
+		u.size[rootQ] += u.size[rootP]
 	} else if u.rank[rootP] > u.rank[rootQ] {
 		u.parent[rootQ] = rootP
+		u.size[rootP] += u.size[rootQ]
 	} else {
 		u.parent[rootQ] = rootP
+		u.size[rootP] += u.size[rootQ]
 		u.rank[rootP]++
 	}
 
+	// splice the two circular member lists into one
+	u.next[rootP], u.next[rootQ] = u.next[rootQ], u.next[rootP]
+
 	u.count--
 }
 
@@ -70,6 +82,17 @@ func (u *UF) Connected(p, q int) bool {
 	return u.Find(p) == u.Find(q)
 }
 
+// Size returns the number of elements in the set containing element p
+func (u *UF) Size(p int) int {
+	return u.size[u.Find(p)]
+}
+
+// Members returns the elements in the set containing element p
+func (u *UF) Members(p int) []int {
+	u.validate(p)
+	return members(u.next, p)
+}
+
 // validate that p is a valid index
 func (u UF) validate(p int) {
 	n := len(u.parent)
@@ -13,3 +13,15 @@ type UnionFind interface {
 	// Count returns Number of components
 	Count() int
 }
+
+// members walks the circular member list that starts at p.
+// Each set keeps its elements on a circular linked list (next[]), and a
+// union splices two lists by swapping the successors of the two roots, so
+// enumerating a set takes time proportional to its size.
+func members(next []int, p int) []int {
+	ms := []int{p}
+	for x := next[p]; x != p; x = next[x] {
+		ms = append(ms, x)
+	}
+	return ms
+}
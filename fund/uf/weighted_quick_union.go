@@ -8,17 +8,20 @@ type WeightedQuickUnion struct {
 	parent []int // parent[i]: parent of i
 	count  int   // number of components
 	size   []int // size[i]: number of elements in subtree rooted at i
+	next   []int // next[i]: next element in the (circular) member list of i's set
 }
 
 func NewWeightedQuickUnion(n int) *WeightedQuickUnion {
 	parent := make([]int, n)
 	size := make([]int, n)
+	next := make([]int, n)
 	for i := 0; i < n; i++ {
 		parent[i] = i
 		size[i] = 1
+		next[i] = i
 	}
 
-	return &WeightedQuickUnion{parent, n, size}
+	return &WeightedQuickUnion{parent, n, size, next}
 }
 
 func (qu *WeightedQuickUnion) Union(p, q int) {
@@ -37,6 +40,9 @@ func (qu *WeightedQuickUnion) Union(p, q int) {
 		qu.size[rootP] += qu.size[rootQ]
 	}
 
+	// splice the two circular member lists into one
+	qu.next[rootP], qu.next[rootQ] = qu.next[rootQ], qu.next[rootP]
+
 	qu.count--
 }
 
@@ -56,6 +62,17 @@ func (qu *WeightedQuickUnion) Connected(p, q int) bool {
 	return qu.Find(p) == qu.Find(q)
 }
 
+// Size returns the number of elements in the component containing p
+func (qu *WeightedQuickUnion) Size(p int) int {
+	return qu.size[qu.Find(p)]
+}
+
+// Members returns the elements in the component containing p
+func (qu *WeightedQuickUnion) Members(p int) []int {
+	qu.validate(p)
+	return members(qu.next, p)
+}
+
 func (qu *WeightedQuickUnion) validate(p int) {
 	n := len(qu.parent)
 	if p < 0 || p >= n {
@@ -30,3 +30,33 @@ func BenchmarkTwoSumCountFast(b *testing.B) {
 		TwoSumCountFast(a[0:])
 	}
 }
+
+func BenchmarkTwoSumCountHash(b *testing.B) {
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		TwoSumCountHash(a[0:], 0)
+	}
+}
+
+func BenchmarkThreeSumCountFast(b *testing.B) {
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ThreeSumCountFast(a[0:])
+	}
+}
+
+func BenchmarkThreeSumCountQuadratic(b *testing.B) {
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ThreeSumCountQuadratic(a[0:], 0)
+	}
+}
@@ -0,0 +1,159 @@
+package xsum
+
+import "sort"
+
+// k-sum.
+// Generalizes 2-sum and 3-sum: given an array of N integers, find the k-tuples
+// of indices i1<i2<...<ik such that a[i1]+a[i2]+...+a[ik]==target.
+// Unlike TwoSumCountFast() and ThreeSumCountFast(), these functions allow
+// duplicate integers (a tuple is a choice of positions, so equal values at
+// different positions give different tuples) and leave the input untouched.
+
+// TwoSumCountHash returns the number of pairs (i, j) with i<j
+// such that a[i]+a[j]==target.
+// It uses a hash table of the values seen so far, linear time.
+func TwoSumCountHash(a []int, target int) int {
+	seen := make(map[int]int, len(a)) // value -> number of occurrences so far
+	count := 0
+
+	for _, v := range a {
+		count += seen[target-v]
+		seen[v]++
+	}
+
+	return count
+}
+
+// ThreeSumCountQuadratic returns the number of triples (i, j, k) with i<j<k
+// such that a[i]+a[j]+a[k]==target.
+// It sorts a copy of the array, then for each i, scans the rest of the array
+// with two pointers moving toward each other: quadratic time.
+func ThreeSumCountQuadratic(a []int, target int) int {
+	return kSumCount(sortedCopy(a), 3, target)
+}
+
+// KSumCount returns the number of k-tuples of indices i1<i2<...<ik
+// such that a[i1]+a[i2]+...+a[ik]==target.
+// Time is linear for k<=2 and proportional to N^(k-1) otherwise.
+func KSumCount(a []int, k, target int) int {
+	switch {
+	case k < 1:
+		panic("k must be positive")
+	case k == 1:
+		count := 0
+		for _, v := range a {
+			if v == target {
+				count++
+			}
+		}
+		return count
+	case k == 2:
+		return TwoSumCountHash(a, target)
+	default:
+		return kSumCount(sortedCopy(a), k, target)
+	}
+}
+
+// counts k-tuples of positions in the sorted array a, k >= 2
+func kSumCount(a []int, k, target int) int {
+	if k == 2 {
+		return twoSumCountSorted(a, target)
+	}
+
+	count := 0
+	for i := 0; i+k <= len(a); i++ {
+		count += kSumCount(a[i+1:], k-1, target-a[i])
+	}
+	return count
+}
+
+// two pointers on a sorted array, counting runs of equal values at once
+func twoSumCountSorted(a []int, target int) int {
+	count := 0
+	lo, hi := 0, len(a)-1
+
+	for lo < hi {
+		sum := a[lo] + a[hi]
+		if sum < target {
+			lo++
+		} else if sum > target {
+			hi--
+		} else if a[lo] == a[hi] {
+			// every pair in a[lo..hi] sums to target
+			n := hi - lo + 1
+			count += n * (n - 1) / 2
+			break
+		} else {
+			l := lo
+			for lo < hi && a[lo] == a[l] {
+				lo++
+			}
+			h := hi
+			for hi >= lo && a[hi] == a[h] {
+				hi--
+			}
+			count += (lo - l) * (h - hi)
+		}
+	}
+
+	return count
+}
+
+// KSum returns the k-tuples of indices i1<i2<...<ik such that
+// a[i1]+a[i2]+...+a[ik]==target, in lexicographic order.
+// Pairs are found with a hash table of indices, larger tuples by fixing
+// the first index and recurring on the rest of the array.
+func KSum(a []int, k, target int) [][]int {
+	if k < 1 {
+		panic("k must be positive")
+	}
+
+	tuples := kSum(a, 0, k, target)
+	sort.Slice(tuples, func(i, j int) bool {
+		for x := range tuples[i] {
+			if tuples[i][x] != tuples[j][x] {
+				return tuples[i][x] < tuples[j][x]
+			}
+		}
+		return false
+	})
+
+	return tuples
+}
+
+// finds k-tuples in a[from:]
+func kSum(a []int, from, k, target int) [][]int {
+	var tuples [][]int
+
+	switch k {
+	case 1:
+		for i := from; i < len(a); i++ {
+			if a[i] == target {
+				tuples = append(tuples, []int{i})
+			}
+		}
+	case 2:
+		seen := make(map[int][]int) // value -> indices so far
+		for j := from; j < len(a); j++ {
+			for _, i := range seen[target-a[j]] {
+				tuples = append(tuples, []int{i, j})
+			}
+			seen[a[j]] = append(seen[a[j]], j)
+		}
+	default:
+		for i := from; i+k <= len(a); i++ {
+			for _, rest := range kSum(a, i+1, k-1, target-a[i]) {
+				tuples = append(tuples, append([]int{i}, rest...))
+			}
+		}
+	}
+
+	return tuples
+}
+
+func sortedCopy(a []int) []int {
+	b := make([]int, len(a))
+	copy(b, a)
+	sort.Ints(b)
+	return b
+}
@@ -1,6 +1,7 @@
 package xsum_test
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"testing"
@@ -102,8 +103,124 @@ func TestTwoSumCountFast(t *testing.T) {
 
 }
 
+func TestTwoSumCountHash(t *testing.T) {
+	want := []int{1, 2, 3}
+
+	got := []int{
+		TwoSumCountHash(ints1K, 0),
+		TwoSumCountHash(ints2K, 0),
+		TwoSumCountHash(ints4K, 0),
+	}
+
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got: %v; want: %v", got, want)
+		}
+	}
+}
+
+func TestThreeSumCountQuadratic(t *testing.T) {
+	want := []int{70, 528, 4039}
+
+	got := []int{
+		ThreeSumCountQuadratic(ints1K, 0),
+		ThreeSumCountQuadratic(ints2K, 0),
+		ThreeSumCountQuadratic(ints4K, 0),
+	}
+
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got: %v; want: %v", got, want)
+		}
+	}
+}
+
+// brute force: counts k-tuples of indices by enumerating all of them
+func kSumBrute(a []int, from, k, target int) int {
+	if k == 0 {
+		if target == 0 {
+			return 1
+		}
+		return 0
+	}
+	count := 0
+	for i := from; i < len(a); i++ {
+		count += kSumBrute(a, i+1, k-1, target-a[i])
+	}
+	return count
+}
+
+func TestKSum_duplicates(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	for trial := 0; trial < 50; trial++ {
+		// small range of values, so lots of duplicates
+		a := make([]int, r.UniformIntRange(0, 20))
+		for i := range a {
+			a[i] = r.UniformIntRange(-5, 6)
+		}
+		orig := append([]int{}, a...)
+
+		for k := 1; k <= 4; k++ {
+			for _, target := range []int{0, 3, -7} {
+				want := kSumBrute(a, 0, k, target)
+
+				if got := KSumCount(a, k, target); got != want {
+					t.Fatalf("KSumCount(%v, %d, %d) = %d; want %d", a, k, target, got, want)
+				}
+
+				tuples := KSum(a, k, target)
+				if len(tuples) != want {
+					t.Fatalf("KSum(%v, %d, %d): %d tuples; want %d", a, k, target, len(tuples), want)
+				}
+				for _, tuple := range tuples {
+					sum := 0
+					for x, i := range tuple {
+						if x > 0 && tuple[x-1] >= i {
+							t.Fatalf("KSum(%v, %d, %d): indices not increasing %v", a, k, target, tuple)
+						}
+						sum += a[i]
+					}
+					if sum != target {
+						t.Fatalf("KSum(%v, %d, %d): %v sums to %d", a, k, target, tuple, sum)
+					}
+				}
+			}
+		}
+
+		if k := 3; ThreeSumCountQuadratic(a, 1) != kSumBrute(a, 0, k, 1) {
+			t.Fatalf("ThreeSumCountQuadratic(%v, 1) = %d; want %d", a, ThreeSumCountQuadratic(a, 1), kSumBrute(a, 0, k, 1))
+		}
+
+		for i := range a {
+			if a[i] != orig[i] {
+				t.Fatalf("input modified: %v; was %v", a, orig)
+			}
+		}
+	}
+}
+
+func TestKSum(t *testing.T) {
+	a := []int{30, -40, -20, -10, 40, 0, 10, 5}
+
+	got := KSum(a, 3, 0)
+	want := [][]int{{0, 1, 6}, {0, 2, 3}, {1, 4, 5}, {3, 5, 6}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	got = KSum([]int{1, 1, 1, 2}, 2, 2)
+	want = [][]int{{0, 1}, {0, 2}, {1, 2}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+}
+
 // go test -v
 // TestThreeSumCount (12.18s)
 // TestThreeSumCountFast (0.40s)
 // TestTwoSumCount (0.01s)
 // TestTwoSumCountFast (0.00s)
+// TestTwoSumCountHash (0.00s)
+// TestThreeSumCountQuadratic (0.04s)
@@ -1,3 +1,3 @@
 module github.com/youngzhu/algs4-go
 
-go 1.16
+go 1.18
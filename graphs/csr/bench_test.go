@@ -0,0 +1,122 @@
+package csr_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs/csr"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/sp"
+)
+
+// go test -v -run="none" -bench="." -benchtime="3s"
+// Each benchmark runs the same algorithm on the linked-list graph (bag) and
+// on its CSR copy (csr); the parallel versions of BFS and CC use GOMAXPROCS
+// goroutines.
+
+const (
+	benchV = 1 << 17
+	benchE = 1 << 20
+)
+
+var (
+	benchOnce sync.Once
+	benchG    *graph.Graph
+	benchDG   *digraph.Digraph
+	benchEWD  *digraph.EdgeWeightedDigraph
+)
+
+func benchInit() {
+	benchG, benchDG, benchEWD = randomGraphs(benchV, benchE)
+}
+
+func BenchmarkBreadthFirstPaths(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    graph.IGraph
+	}{{"bag", benchG}, {"csr", csr.NewGraph(benchG)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				graph.NewBreadthFirstPathsOf(bc.g, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkConnectedComponents(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    graph.IGraph
+	}{{"bag", benchG}, {"csr", csr.NewGraph(benchG)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				graph.NewConnectedComponentsOf(bc.g)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelBreadthFirstPaths(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    graph.IGraph
+	}{{"bag", benchG}, {"csr", csr.NewGraph(benchG)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				graph.NewParallelBreadthFirstPaths(bc.g, 0, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelConnectedComponents(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    graph.IGraph
+	}{{"bag", benchG}, {"csr", csr.NewGraph(benchG)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				graph.NewParallelConnectedComponents(bc.g, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkKosarajuSharirSCC(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    digraph.IDigraph
+	}{{"bag", benchDG}, {"csr", csr.NewDigraph(benchDG)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				digraph.NewKosarajuSharirSCCOf(bc.g)
+			}
+		})
+	}
+}
+
+func BenchmarkDijkstraSP(b *testing.B) {
+	benchOnce.Do(benchInit)
+	for _, bc := range []struct {
+		name string
+		g    digraph.IEdgeWeightedDigraph
+	}{{"bag", benchEWD}, {"csr", csr.NewEdgeWeightedDigraph(benchEWD)}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sp.NewDijkstraSPOf(bc.g, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package csr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Compressed sparse row (CSR) graphs.
+// The adjacency lists of all the vertices are stored one after the other in
+// a single array of vertices, and the adjacency list of vertex v is the slice
+// between offset[v] and offset[v+1]. Compared to the linked lists of Graph
+// and Digraph, there is one pointer-free array instead of a node per edge, so
+// scanning an adjacency list reads contiguous memory. The graphs are frozen:
+// they are built from another graph, or read or memory-mapped from a binary
+// file (see WriteTo and OpenGraph), and AddEdge panics.
+//
+// The graphs satisfy the usual interfaces, so all the algorithms accept them.
+// The algorithms that know about graph.CompactAdj (BreadthFirstPaths,
+// ConnectedComponents, DepthFirstOrder, KosarajuSharirSCC, DijkstraSP) scan
+// the arrays in place; the others go through Adj, which boxes the vertices
+// of the list, and are slower than with the linked lists.
+
+// vertices are stored as int32
+const maxVertices = math.MaxInt32
+
+// arrays are the representation shared by the CSR graphs: the vertices
+// adjacent to v, and the weights of the edges to them, are
+// target[offset[v]:offset[v+1]] and weight[offset[v]:offset[v+1]]
+type arrays struct {
+	v, e   int
+	offset []int64
+	target []int32
+	weight []float64 // nil if the graph is not weighted
+	mapped []byte    // the memory-mapped file, if any
+}
+
+func (a *arrays) validateVertex(v int) {
+	if v < 0 || v >= a.v {
+		panic("invalidate vertex")
+	}
+}
+
+// V returns the number of vertices
+func (a *arrays) V() int {
+	return a.v
+}
+
+// E returns the number of edges
+func (a *arrays) E() int {
+	return a.e
+}
+
+// Targets returns the vertices adjacent to v, in the order of Adj(v).
+// The slice is part of the graph and must not be modified.
+func (a *arrays) Targets(v int) []int32 {
+	a.validateVertex(v)
+	return a.target[a.offset[v]:a.offset[v+1]]
+}
+
+// Close releases the memory-mapped file of a graph opened with OpenGraph or
+// OpenEdgeWeightedDigraph. The graph must not be used after Close.
+// It does nothing for other graphs.
+func (a *arrays) Close() error {
+	if a.mapped == nil {
+		return nil
+	}
+	err := unmap(a.mapped)
+	*a = arrays{}
+	return err
+}
+
+// builds the arrays of a graph with n vertices, where adj(v) appends the
+// vertices adjacent to v (and the weights) to the target (and weight) slices
+func build(n, e, size int, weighted bool, adj func(v int, target []int32, weight []float64) ([]int32, []float64)) arrays {
+	if n > maxVertices {
+		panic(fmt.Sprintf("a CSR graph has at most %d vertices", maxVertices))
+	}
+
+	a := arrays{
+		v:      n,
+		e:      e,
+		offset: make([]int64, n+1),
+		target: make([]int32, 0, size),
+	}
+	if weighted {
+		a.weight = make([]float64, 0, size)
+	}
+	for v := 0; v < n; v++ {
+		a.target, a.weight = adj(v, a.target, a.weight)
+		a.offset[v+1] = int64(len(a.target))
+	}
+	return a
+}
+
+// Graph is an immutable graph or digraph in compressed sparse row format.
+// An undirected edge v-w is in the adjacency lists of both v and w.
+type Graph struct {
+	arrays
+	directed bool
+}
+
+// NewGraph returns a CSR copy of the undirected graph g.
+// The adjacency lists are in the same order as in g.
+func NewGraph(g graph.IGraph) *Graph {
+	return &Graph{newArrays(g, 2*g.E()), false}
+}
+
+// NewDigraph returns a CSR copy of the digraph g.
+// The adjacency lists are in the same order as in g.
+func NewDigraph(g digraph.IDigraph) *Graph {
+	return &Graph{newArrays(g, g.E()), true}
+}
+
+func newArrays(g graph.IGraph, size int) arrays {
+	return build(g.V(), g.E(), size, false, func(v int, target []int32, _ []float64) ([]int32, []float64) {
+		if c, ok := g.(graph.CompactAdj); ok {
+			return append(target, c.Targets(v)...), nil
+		}
+		for _, w := range g.Adj(v) {
+			target = append(target, int32(w.(int)))
+		}
+		return target, nil
+	})
+}
+
+// Directed returns true if the graph is a digraph
+func (g *Graph) Directed() bool {
+	return g.directed
+}
+
+// AddEdge panics: CSR graphs are immutable
+func (g *Graph) AddEdge(v, w int) {
+	panic("CSR graph is immutable")
+}
+
+// Adj returns the vertices adjacent to v.
+// Use Targets to scan them without boxing.
+func (g *Graph) Adj(v int) fund.Iterator {
+	targets := g.Targets(v)
+	items := make([]interface{}, len(targets))
+	for i, w := range targets {
+		items[i] = int(w)
+	}
+	return items
+}
+
+// Degree returns the degree of v, or its outdegree in a digraph
+func (g *Graph) Degree(v int) int {
+	g.validateVertex(v)
+	return int(g.offset[v+1] - g.offset[v])
+}
+
+// Reverse returns the reverse of a digraph, in CSR format.
+// An undirected graph is its own reverse.
+func (g *Graph) Reverse() *Graph {
+	if !g.directed {
+		return g
+	}
+
+	// counting sort of the edges by target
+	offset := make([]int64, g.v+1)
+	for _, w := range g.target {
+		offset[w+1]++
+	}
+	for v := 0; v < g.v; v++ {
+		offset[v+1] += offset[v]
+	}
+	next := make([]int64, g.v)
+	copy(next, offset)
+
+	target := make([]int32, len(g.target))
+	for v := 0; v < g.v; v++ {
+		for _, w := range g.target[g.offset[v]:g.offset[v+1]] {
+			target[next[w]] = int32(v)
+			next[w]++
+		}
+	}
+
+	return &Graph{arrays{v: g.v, e: g.e, offset: offset, target: target}, true}
+}
+
+// ReverseDigraph returns Reverse(), for digraph.Reversible
+func (g *Graph) ReverseDigraph() digraph.IDigraph {
+	return g.Reverse()
+}
+
+// String returns a string representation of this graph,
+// in the format of Graph and Digraph
+func (g *Graph) String() string {
+	s := fmt.Sprintf("%d vertices, %d edges\n", g.v, g.e)
+	for v := 0; v < g.v; v++ {
+		adjs := ""
+		for _, w := range g.Targets(v) {
+			adjs += fmt.Sprintf(" %d", w)
+		}
+		s += fmt.Sprintf("%d:%s\n", v, adjs)
+	}
+	return s
+}
@@ -0,0 +1,233 @@
+package csr_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs/csr"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/sp"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// random graphs with parallel edges and self-loops, and isolated vertices
+func randomGraphs(v, e int) (*graph.Graph, *digraph.Digraph, *digraph.EdgeWeightedDigraph) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	g := graph.NewGraphN(v)
+	dg := digraph.NewDigraphN(v)
+	ewd := digraph.NewEdgeWeightedDigraphN(v)
+	for i := 0; i < e; i++ {
+		x, y := r.Intn(v-v/10), r.Intn(v-v/10)
+		g.AddEdge(x, y)
+		dg.AddEdge(x, y)
+		ewd.AddEdge(digraph.NewDirectedEdge(x, y, r.Float64()))
+	}
+	return g, dg, ewd
+}
+
+func TestNew(t *testing.T) {
+	g, dg, ewd := randomGraphs(100, 300)
+
+	if c := csr.NewGraph(g); c.String() != g.String() || c.Directed() {
+		t.Errorf("graph:\n%vwant:\n%v", c, g)
+	}
+	if c := csr.NewDigraph(dg); c.String() != dg.String() || !c.Directed() {
+		t.Errorf("digraph:\n%vwant:\n%v", c, dg)
+	}
+	if c := csr.NewEdgeWeightedDigraph(ewd); c.String() != ewd.String() {
+		t.Errorf("edge-weighted digraph:\n%vwant:\n%v", c, ewd)
+	}
+
+	// copy of a copy
+	c := csr.NewDigraph(csr.NewDigraph(dg))
+	if c.String() != dg.String() {
+		t.Errorf("copy of copy:\n%vwant:\n%v", c, dg)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	_, dg, _ := randomGraphs(100, 300)
+
+	c, want := csr.NewDigraph(dg).Reverse(), dg.Reverse()
+	if c.V() != want.V() || c.E() != want.E() {
+		t.Fatalf("reverse: %d vertices, %d edges, want %d, %d", c.V(), c.E(), want.V(), want.E())
+	}
+	for v := 0; v < c.V(); v++ {
+		var got, exp []int
+		for _, w := range c.Targets(v) {
+			got = append(got, int(w))
+		}
+		for _, w := range want.Adj(v) {
+			exp = append(exp, w.(int))
+		}
+		sort.Ints(got)
+		sort.Ints(exp)
+		if !equal(got, exp) {
+			t.Errorf("reverse adj(%d) = %v, want %v", v, got, exp)
+		}
+	}
+}
+
+func TestFile(t *testing.T) {
+	g, dg, ewd := randomGraphs(100, 301)
+	dir := t.TempDir()
+
+	for name, c := range map[string]*csr.Graph{"graph": csr.NewGraph(g), "digraph": csr.NewDigraph(dg)} {
+		var buf bytes.Buffer
+		n, err := c.WriteTo(&buf)
+		if err != nil || n != int64(buf.Len()) {
+			t.Fatalf("%s: WriteTo = %d, %v; %d bytes written", name, n, err, buf.Len())
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		read, err := csr.ReadGraph(bytes.NewReader(buf.Bytes()))
+		if err != nil || read.String() != c.String() || read.Directed() != c.Directed() {
+			t.Errorf("%s: ReadGraph = %v, %v", name, read, err)
+		}
+		opened, err := csr.OpenGraph(path)
+		if err != nil || opened.String() != c.String() || opened.Directed() != c.Directed() {
+			t.Errorf("%s: OpenGraph = %v, %v", name, opened, err)
+		}
+		if err := opened.Close(); err != nil {
+			t.Error(err)
+		}
+
+		if _, err := csr.ReadEdgeWeightedDigraph(bytes.NewReader(buf.Bytes())); err != csr.ErrFormat {
+			t.Errorf("%s: ReadEdgeWeightedDigraph error = %v, want ErrFormat", name, err)
+		}
+		if _, err := csr.ReadGraph(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); err != csr.ErrFormat {
+			t.Errorf("%s: truncated file error = %v, want ErrFormat", name, err)
+		}
+		os.WriteFile(path, buf.Bytes()[:buf.Len()-8], 0644)
+		if _, err := csr.OpenGraph(path); err != csr.ErrFormat {
+			t.Errorf("%s: OpenGraph truncated file error = %v, want ErrFormat", name, err)
+		}
+	}
+
+	c := csr.NewEdgeWeightedDigraph(ewd)
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "ewd")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	read, err := csr.ReadEdgeWeightedDigraph(bytes.NewReader(buf.Bytes()))
+	if err != nil || read.String() != c.String() {
+		t.Errorf("ReadEdgeWeightedDigraph = %v, %v", read, err)
+	}
+	opened, err := csr.OpenEdgeWeightedDigraph(path)
+	if err != nil || opened.String() != c.String() {
+		t.Errorf("OpenEdgeWeightedDigraph = %v, %v", opened, err)
+	}
+	opened.Close()
+
+	if _, err := csr.ReadGraph(bytes.NewReader([]byte("not a graph"))); err != csr.ErrFormat {
+		t.Errorf("ReadGraph error = %v, want ErrFormat", err)
+	}
+}
+
+// corrupt files give ErrFormat, not a graph that panics
+func TestFile_corrupt(t *testing.T) {
+	_, dg, _ := randomGraphs(100, 301)
+	var buf bytes.Buffer
+	if _, err := csr.NewDigraph(dg).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	le := binary.LittleEndian
+	const header, targets = 40, 40 + 8*101
+
+	tests := []struct {
+		name    string
+		corrupt func(b []byte)
+	}{
+		{"target out of range", func(b []byte) { le.PutUint32(b[targets:], 100) }},
+		{"negative target", func(b []byte) { le.PutUint32(b[targets:], 1<<31) }},
+		{"decreasing offsets", func(b []byte) { le.PutUint64(b[header+8:], 302) }},
+		{"first offset", func(b []byte) { le.PutUint64(b[header:], 1) }},
+		{"huge header", func(b []byte) { le.PutUint64(b[24:], 1<<39); le.PutUint64(b[32:], 1<<39) }},
+	}
+	for _, tt := range tests {
+		b := append([]byte(nil), buf.Bytes()...)
+		tt.corrupt(b)
+		if _, err := csr.ReadGraph(bytes.NewReader(b)); err != csr.ErrFormat {
+			t.Errorf("%s: ReadGraph error = %v, want ErrFormat", tt.name, err)
+		}
+		path := filepath.Join(dir, "corrupt")
+		if err := os.WriteFile(path, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := csr.OpenGraph(path); err != csr.ErrFormat {
+			t.Errorf("%s: OpenGraph error = %v, want ErrFormat", tt.name, err)
+		}
+	}
+}
+
+// the algorithms give the same results on CSR graphs
+func TestAlgorithms(t *testing.T) {
+	g, dg, ewd := randomGraphs(1000, 1500)
+
+	c := csr.NewGraph(g)
+	bfs, cbfs := graph.NewBreadthFirstPathsOf(g, 0), graph.NewBreadthFirstPathsOf(c, 0)
+	cc, ccc := graph.NewConnectedComponentsOf(g), graph.NewConnectedComponentsOf(c)
+	for v := 0; v < g.V(); v++ {
+		if bfs.DistTo(v) != cbfs.DistTo(v) {
+			t.Errorf("BFS distTo(%d) = %d, want %d", v, cbfs.DistTo(v), bfs.DistTo(v))
+		}
+		if cc.Id(v) != ccc.Id(v) {
+			t.Errorf("CC id(%d) = %d, want %d", v, ccc.Id(v), cc.Id(v))
+		}
+	}
+
+	scc, cscc := digraph.NewKosarajuSharirSCCOf(dg), digraph.NewKosarajuSharirSCCOf(csr.NewDigraph(dg))
+	if scc.Count() != cscc.Count() {
+		t.Errorf("SCC count = %d, want %d", cscc.Count(), scc.Count())
+	}
+	for v := 0; v < dg.V(); v++ {
+		for w := 0; w < dg.V(); w += 7 {
+			if scc.StronglyConnected(v, w) != cscc.StronglyConnected(v, w) {
+				t.Fatalf("SCC StronglyConnected(%d, %d) = %v", v, w, cscc.StronglyConnected(v, w))
+			}
+		}
+	}
+
+	dsp, cdsp := sp.NewDijkstraSPOf(ewd, 0), sp.NewDijkstraSPOf(csr.NewEdgeWeightedDigraph(ewd), 0)
+	for v := 0; v < ewd.V(); v++ {
+		if dsp.DistTo(v) != cdsp.DistTo(v) || len(dsp.PathTo(v)) != len(cdsp.PathTo(v)) {
+			t.Errorf("Dijkstra distTo(%d) = %v, want %v", v, cdsp.DistTo(v), dsp.DistTo(v))
+		}
+	}
+}
+
+func TestImmutable(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("AddEdge did not panic")
+		}
+	}()
+	csr.NewGraph(graph.NewGraphN(2)).AddEdge(0, 1)
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package csr
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+)
+
+// EdgeWeightedDigraph is an immutable edge-weighted digraph in compressed
+// sparse row format: the weights are in an array parallel to the targets.
+type EdgeWeightedDigraph struct {
+	arrays
+}
+
+// NewEdgeWeightedDigraph returns a CSR copy of the edge-weighted digraph g.
+// The adjacency lists are in the same order as in g.
+func NewEdgeWeightedDigraph(g digraph.IEdgeWeightedDigraph) *EdgeWeightedDigraph {
+	a := build(g.V(), g.E(), g.E(), true, func(v int, target []int32, weight []float64) ([]int32, []float64) {
+		if c, ok := g.(digraph.CompactWeightedAdj); ok {
+			return append(target, c.Targets(v)...), append(weight, c.Weights(v)...)
+		}
+		for _, edge := range g.Adj(v) {
+			e := edge.(*digraph.DirectedEdge)
+			target = append(target, int32(e.To()))
+			weight = append(weight, e.Weight())
+		}
+		return target, weight
+	})
+	return &EdgeWeightedDigraph{a}
+}
+
+// AddEdge panics: CSR graphs are immutable
+func (g *EdgeWeightedDigraph) AddEdge(e *digraph.DirectedEdge) {
+	panic("CSR graph is immutable")
+}
+
+// Weights returns the weights of the edges incident from v, in the order of
+// Targets(v). The slice is part of the graph and must not be modified.
+func (g *EdgeWeightedDigraph) Weights(v int) []float64 {
+	g.validateVertex(v)
+	return g.weight[g.offset[v]:g.offset[v+1]]
+}
+
+// Adj returns the edges incident from v. The edges are made on each call:
+// use Targets and Weights to scan them without allocations.
+func (g *EdgeWeightedDigraph) Adj(v int) fund.Iterator {
+	targets, weights := g.Targets(v), g.Weights(v)
+	items := make([]interface{}, len(targets))
+	for i, w := range targets {
+		items[i] = digraph.NewDirectedEdge(v, int(w), weights[i])
+	}
+	return items
+}
+
+// Outdegree returns the number of edges incident from v
+func (g *EdgeWeightedDigraph) Outdegree(v int) int {
+	g.validateVertex(v)
+	return int(g.offset[v+1] - g.offset[v])
+}
+
+// Edges returns all the edges of this digraph
+func (g *EdgeWeightedDigraph) Edges() fund.Iterator {
+	edges := make([]interface{}, 0, g.e)
+	for v := 0; v < g.v; v++ {
+		edges = append(edges, g.Adj(v)...)
+	}
+	return edges
+}
+
+// String returns a string representation of this digraph,
+// in the format of digraph.EdgeWeightedDigraph
+func (g *EdgeWeightedDigraph) String() string {
+	s := fmt.Sprintf("vertices:%d, edges:%d\n", g.v, g.e)
+	for v := 0; v < g.v; v++ {
+		s += fmt.Sprintf("%d:", v)
+		for _, e := range g.Adj(v) {
+			s += fmt.Sprintf(" %v", e)
+		}
+		s += fmt.Sprintln()
+	}
+	return s
+}
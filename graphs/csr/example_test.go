@@ -0,0 +1,73 @@
+package csr_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/youngzhu/algs4-go/graphs/csr"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+func ExampleNewGraph() {
+	g := graph.NewGraphN(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 3)
+
+	c := csr.NewGraph(g)
+	fmt.Print(c)
+	fmt.Println("targets of 0:", c.Targets(0))
+
+	cc := graph.NewConnectedComponentsOf(c)
+	fmt.Println("components:", cc.Count())
+
+	// Output:
+	// 5 vertices, 4 edges
+	// 0: 2 1
+	// 1: 0
+	// 2: 3 0
+	// 3: 3 3 2
+	// 4:
+	// targets of 0: [2 1]
+	// components: 2
+}
+
+func ExampleOpenGraph() {
+	g := digraph.NewDigraphN(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+
+	dir, _ := os.MkdirTemp("", "csr")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "digraph.csr")
+
+	f, _ := os.Create(path)
+	n, _ := csr.NewDigraph(g).WriteTo(f)
+	f.Close()
+	fmt.Println("bytes written:", n)
+
+	c, err := csr.OpenGraph(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer c.Close()
+
+	scc := digraph.NewKosarajuSharirSCCOf(c)
+	fmt.Println("strong components:", scc.Count())
+	fmt.Print("reverse: ", c.Reverse())
+
+	// Output:
+	// bytes written: 96
+	// strong components: 2
+	// reverse: 4 vertices, 4 edges
+	// 0: 2
+	// 1: 0
+	// 2: 1
+	// 3: 2
+}
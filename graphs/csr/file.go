@@ -0,0 +1,320 @@
+package csr
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Binary files.
+// A CSR graph is written as a header followed by its arrays, all in little
+// endian, so that the arrays can be used in place when the file is
+// memory-mapped:
+//   magic    8 bytes "algs4csr"
+//   version  uint32, 1
+//   flags    uint32, 1 if directed | 2 if weighted
+//   V, E, N  uint64, N is the total length of the adjacency lists
+//   offset   (V+1) int64
+//   target   N int32, padded with zeros to a multiple of 8 bytes
+//   weight   N float64, if weighted
+// Reading checks the header, the size of the file and the arrays: the offsets
+// must go up from 0 to N, and the targets must be vertices. The weights are
+// not checked.
+
+const (
+	magic      = "algs4csr"
+	version    = 1
+	headerSize = 40
+
+	flagDirected = 1
+	flagWeighted = 2
+)
+
+var ErrFormat = errors.New("csr: invalid file format")
+
+type header struct {
+	flags   uint32
+	v, e, n uint64
+}
+
+func (a *arrays) header(flags uint32) header {
+	if a.weight != nil {
+		flags |= flagWeighted
+	}
+	return header{flags, uint64(a.v), uint64(a.e), uint64(len(a.target))}
+}
+
+// the size of the file with header h, or -1 if it overflows
+func (h header) size() int64 {
+	if h.v > maxVertices || h.n > 1<<40 {
+		return -1
+	}
+	size := headerSize + 8*int64(h.v+1) + 4*int64(h.n+h.n%2)
+	if h.flags&flagWeighted != 0 {
+		size += 8 * int64(h.n)
+	}
+	return size
+}
+
+func (h header) check(weighted bool) error {
+	if (h.flags&flagWeighted != 0) != weighted || h.flags&^(flagDirected|flagWeighted) != 0 {
+		return ErrFormat
+	}
+	if h.flags&flagDirected == 0 && h.n != 2*h.e || h.flags&flagDirected != 0 && h.n != h.e {
+		return ErrFormat
+	}
+	return nil
+}
+
+func (a *arrays) writeTo(w io.Writer, flags uint32) (int64, error) {
+	bw := bufio.NewWriter(w)
+	h := a.header(flags)
+
+	bw.WriteString(magic)
+	for _, x := range []interface{}{uint32(version), h.flags, h.v, h.e, h.n} {
+		binary.Write(bw, binary.LittleEndian, x)
+	}
+	// write errors are kept by bw, and returned by Flush
+	writeChunks(bw, len(a.offset), func(i, j int) interface{} { return a.offset[i:j] })
+	writeChunks(bw, len(a.target), func(i, j int) interface{} { return a.target[i:j] })
+	if len(a.target)%2 != 0 {
+		binary.Write(bw, binary.LittleEndian, int32(0))
+	}
+	if a.weight != nil {
+		writeChunks(bw, len(a.weight), func(i, j int) interface{} { return a.weight[i:j] })
+	}
+
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return h.size(), nil
+}
+
+// number of elements written or read at once: binary.Write and
+// binary.Read copy the whole slice they are given
+const chunkSize = 1 << 14
+
+// writes the n elements of a slice, chunk(i, j) returns the slice [i:j]
+func writeChunks(w io.Writer, n int, chunk func(i, j int) interface{}) error {
+	for i := 0; i < n; i += chunkSize {
+		if err := binary.Write(w, binary.LittleEndian, chunk(i, min(i+chunkSize, n))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reads the n elements of a slice, chunk(i, j) grows the slice to j
+// elements and returns the slice [i:j]. The slice grows as the chunks are
+// read, so a header that claims more elements than there are in the input
+// does not allocate them.
+func readChunks(r io.Reader, n int, chunk func(i, j int) interface{}) error {
+	for i := 0; i < n; i += chunkSize {
+		if err := binary.Read(r, binary.LittleEndian, chunk(i, min(i+chunkSize, n))); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return ErrFormat
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// WriteTo writes the graph in the binary CSR format.
+// It implements io.WriterTo.
+func (g *Graph) WriteTo(w io.Writer) (int64, error) {
+	var flags uint32
+	if g.directed {
+		flags = flagDirected
+	}
+	return g.writeTo(w, flags)
+}
+
+// WriteTo writes the digraph in the binary CSR format.
+// It implements io.WriterTo.
+func (g *EdgeWeightedDigraph) WriteTo(w io.Writer) (int64, error) {
+	return g.writeTo(w, flagDirected)
+}
+
+func readHeader(r io.Reader) (header, error) {
+	var buf [headerSize]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return header{}, ErrFormat
+		}
+		return header{}, err
+	}
+	return parseHeader(buf[:])
+}
+
+func parseHeader(buf []byte) (header, error) {
+	le := binary.LittleEndian
+	if string(buf[:8]) != magic {
+		return header{}, ErrFormat
+	}
+	if v := le.Uint32(buf[8:]); v != version {
+		return header{}, fmt.Errorf("csr: unsupported version %d", v)
+	}
+	h := header{le.Uint32(buf[12:]), le.Uint64(buf[16:]), le.Uint64(buf[24:]), le.Uint64(buf[32:])}
+	if h.size() < 0 {
+		return header{}, ErrFormat
+	}
+	return h, nil
+}
+
+func readArrays(r io.Reader, weighted bool) (arrays, uint32, error) {
+	br := bufio.NewReader(r)
+	h, err := readHeader(br)
+	if err == nil {
+		err = h.check(weighted)
+	}
+	if err != nil {
+		return arrays{}, 0, err
+	}
+
+	a := arrays{v: int(h.v), e: int(h.e)}
+	err = readChunks(br, int(h.v+1), func(i, j int) interface{} {
+		a.offset = append(a.offset, make([]int64, j-i)...)
+		return a.offset[i:j]
+	})
+	if err == nil {
+		err = readChunks(br, int(h.n+h.n%2), func(i, j int) interface{} {
+			a.target = append(a.target, make([]int32, j-i)...)
+			return a.target[i:j]
+		})
+	}
+	if err == nil && weighted {
+		err = readChunks(br, int(h.n), func(i, j int) interface{} {
+			a.weight = append(a.weight, make([]float64, j-i)...)
+			return a.weight[i:j]
+		})
+	}
+	if err != nil {
+		return arrays{}, 0, err
+	}
+	a.target = a.target[:h.n]
+	if !a.valid() {
+		return arrays{}, 0, ErrFormat
+	}
+
+	return a, h.flags, nil
+}
+
+// Returns true if the offsets go up from 0 to the number of targets, and the
+// targets are vertices
+func (a *arrays) valid() bool {
+	if a.offset[0] != 0 || a.offset[a.v] != int64(len(a.target)) {
+		return false
+	}
+	for v := 0; v < a.v; v++ {
+		if a.offset[v] > a.offset[v+1] {
+			return false
+		}
+	}
+	for _, w := range a.target {
+		if w < 0 || int(w) >= a.v {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadGraph reads a graph or digraph written by Graph.WriteTo
+func ReadGraph(r io.Reader) (*Graph, error) {
+	a, flags, err := readArrays(r, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Graph{a, flags&flagDirected != 0}, nil
+}
+
+// ReadEdgeWeightedDigraph reads a digraph written by
+// EdgeWeightedDigraph.WriteTo
+func ReadEdgeWeightedDigraph(r io.Reader) (*EdgeWeightedDigraph, error) {
+	a, _, err := readArrays(r, true)
+	if err != nil {
+		return nil, err
+	}
+	return &EdgeWeightedDigraph{a}, nil
+}
+
+// OpenGraph memory-maps a file written by Graph.WriteTo. The arrays of the
+// graph are the pages of the file: opening takes no memory, and the operating
+// system loads the pages as they are used (checking the offsets and the
+// targets reads them once). Call Close to unmap the file. Where
+// memory-mapping is not available, the file is read.
+func OpenGraph(path string) (*Graph, error) {
+	a, flags, err := openArrays(path, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Graph{a, flags&flagDirected != 0}, nil
+}
+
+// OpenEdgeWeightedDigraph memory-maps a file written by
+// EdgeWeightedDigraph.WriteTo, see OpenGraph
+func OpenEdgeWeightedDigraph(path string) (*EdgeWeightedDigraph, error) {
+	a, _, err := openArrays(path, true)
+	if err != nil {
+		return nil, err
+	}
+	return &EdgeWeightedDigraph{a}, nil
+}
+
+func openArrays(path string, weighted bool) (arrays, uint32, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return arrays{}, 0, err
+	}
+	defer f.Close()
+
+	if !canMap {
+		return readArrays(f, weighted)
+	}
+
+	h, err := readHeader(f)
+	if err == nil {
+		err = h.check(weighted)
+	}
+	if err != nil {
+		return arrays{}, 0, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return arrays{}, 0, err
+	}
+	if fi.Size() != h.size() {
+		return arrays{}, 0, ErrFormat
+	}
+
+	data, err := mmap(f, int(h.size()))
+	if err != nil {
+		return arrays{}, 0, err
+	}
+
+	a := arrays{v: int(h.v), e: int(h.e), mapped: data}
+	at := int64(headerSize)
+	a.offset = int64s(data[at : at+8*int64(h.v+1)])
+	at += 8 * int64(h.v+1)
+	a.target = int32s(data[at : at+4*int64(h.n)])
+	at += 4 * int64(h.n+h.n%2)
+	if weighted {
+		a.weight = float64s(data[at : at+8*int64(h.n)])
+	}
+	if !a.valid() {
+		unmap(data)
+		return arrays{}, 0, ErrFormat
+	}
+
+	return a, h.flags, nil
+}
@@ -0,0 +1,20 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package csr
+
+import (
+	"errors"
+	"os"
+)
+
+// memory-mapping is not implemented, files are read
+const canMap = false
+
+func mmap(f *os.File, size int) ([]byte, error) {
+	return nil, errors.New("csr: memory-mapping is not supported")
+}
+
+func unmap(data []byte) error {
+	return nil
+}
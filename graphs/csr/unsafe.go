@@ -0,0 +1,46 @@
+package csr
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// is this machine little endian, like the files?
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// The functions below view bytes of a memory-mapped file as a slice of
+// numbers, without copying. The bytes must be aligned for the numbers:
+// mappings start at a page boundary and every array of a file starts at a
+// multiple of 8 bytes.
+
+func int64s(b []byte) []int64 {
+	var s []int64
+	view(unsafe.Pointer(&s), b, 8)
+	return s
+}
+
+func int32s(b []byte) []int32 {
+	var s []int32
+	view(unsafe.Pointer(&s), b, 4)
+	return s
+}
+
+func float64s(b []byte) []float64 {
+	var s []float64
+	view(unsafe.Pointer(&s), b, 8)
+	return s
+}
+
+// sets the slice at p to the elements of the given size in b
+func view(p unsafe.Pointer, b []byte, size int) {
+	if len(b) == 0 {
+		return
+	}
+	h := (*reflect.SliceHeader)(p)
+	h.Data = uintptr(unsafe.Pointer(&b[0]))
+	h.Len = len(b) / size
+	h.Cap = len(b) / size
+}
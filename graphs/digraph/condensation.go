@@ -0,0 +1,91 @@
+package digraph
+
+// Condensation (kernel DAG) of a digraph.
+// Each strong component of the digraph is contracted to a single vertex, the
+// id of the component, and there is an edge c->d if there is an edge v->w
+// from a vertex v of component c to a vertex w of another component d. The
+// edges are not repeated, and the result is a DAG.
+// With the numbering of KosarajuSharirSCC, TarjanSCC and GabowSCC, all the
+// edges go from a component to a component with a smaller id: the components
+// in decreasing order of id are in topological order.
+
+// SCC is the API of the strong components algorithms
+type SCC interface {
+	Count() int
+	Id(v int) int
+}
+
+type Condensation struct {
+	dag     *Digraph
+	id      []int   // id[v]: id of strong component containing v
+	members [][]int // members[c]: vertices in component c, in increasing order
+}
+
+// Computes the condensation of digraph g with the strong components scc of g
+func NewCondensation(g IDigraph, scc SCC) Condensation {
+	n := g.V()
+	c := Condensation{
+		dag:     NewDigraphN(scc.Count()),
+		id:      make([]int, n),
+		members: make([][]int, scc.Count()),
+	}
+	for v := 0; v < n; v++ {
+		c.id[v] = scc.Id(v)
+		c.members[c.id[v]] = append(c.members[c.id[v]], v)
+	}
+
+	// last[d]: the last component with an edge to d, to skip repeated edges
+	last := make([]int, scc.Count())
+	for d := range last {
+		last[d] = -1
+	}
+	for from, members := range c.members {
+		for _, v := range members {
+			for _, w := range g.Adj(v) {
+				to := c.id[w.(int)]
+				if to != from && last[to] != from {
+					last[to] = from
+					c.dag.AddEdge(from, to)
+				}
+			}
+		}
+	}
+
+	return c
+}
+
+// Returns the condensation as a DAG, whose vertices are the component ids
+func (c Condensation) DAG() *Digraph {
+	return c.dag
+}
+
+// Returns the number of strong components (vertices of the DAG)
+func (c Condensation) Count() int {
+	return len(c.members)
+}
+
+// Returns the component id of the strong component containing vertex v
+func (c Condensation) Id(v int) int {
+	if v < 0 || v >= len(c.id) {
+		panic("invalidate vertex")
+	}
+	return c.id[v]
+}
+
+// Returns the number of vertices in component id
+func (c Condensation) Size(id int) int {
+	c.validateComponent(id)
+	return len(c.members[id])
+}
+
+// Returns the vertices in component id, in increasing order
+func (c Condensation) Members(id int) []int {
+	c.validateComponent(id)
+	return append([]int(nil), c.members[id]...)
+}
+
+func (c Condensation) validateComponent(id int) {
+	if id < 0 || id >= len(c.members) {
+		panic("invalidate component")
+	}
+}
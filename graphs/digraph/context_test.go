@@ -0,0 +1,76 @@
+package digraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestContext(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	g := NewDigraphGenerator(r).Simple(3000, 9000)
+	n := g.V()
+
+	// the same results, and progress up to the vertices processed
+	last := 0
+	progress := func(x int) { last = x }
+
+	dfo, err := NewDepthFirstOrderContext(context.Background(), g, progress)
+	if err != nil || last != n {
+		t.Fatalf("DepthFirstOrder: %v, progress %d", err, last)
+	}
+	want := NewDepthFirstOrder(g)
+	for v := 0; v < n; v++ {
+		if dfo.Pre(v) != want.Pre(v) || dfo.Post(v) != want.Post(v) {
+			t.Fatalf("DepthFirstOrder: different orders of %d", v)
+		}
+	}
+
+	kosaraju, err := NewKosarajuSharirSCCContext(context.Background(), g, progress)
+	if err != nil || last != 2*n {
+		t.Fatalf("KosarajuSharirSCC: %v, progress %d", err, last)
+	}
+	tarjan, err := NewTarjanSCCContext(context.Background(), g, progress)
+	if err != nil || last != n {
+		t.Fatalf("TarjanSCC: %v, progress %d", err, last)
+	}
+	if kosaraju.Count() != NewKosarajuSharirSCC(*g).Count() || tarjan.Count() != kosaraju.Count() {
+		t.Errorf("%d and %d strong components", kosaraju.Count(), tarjan.Count())
+	}
+
+	small := NewDigraphGenerator(r).Simple(100, 300)
+	tc, err := NewTransitiveClosureContext(context.Background(), small, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reachable := 0
+	for v := 0; v < small.V(); v++ {
+		reachable += tc.Count(v)
+	}
+	if last != reachable {
+		t.Errorf("TransitiveClosure: progress %d, want %d", last, reachable)
+	}
+
+	// canceled at the first report
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := func(x int) {
+		last = x
+		cancel()
+	}
+	if _, err := NewKosarajuSharirSCCContext(ctx, g, stop); err != context.Canceled || last != graphs.CheckInterval {
+		t.Errorf("KosarajuSharirSCC: %v after %d vertices", err, last)
+	}
+	if _, err := NewDepthFirstOrderContext(ctx, g, nil); err != context.Canceled {
+		t.Errorf("DepthFirstOrder: %v", err)
+	}
+	if _, err := NewTarjanSCCContext(ctx, g, nil); err != context.Canceled {
+		t.Errorf("TarjanSCC: %v", err)
+	}
+	if _, err := NewTransitiveClosureContext(ctx, g, nil); err != context.Canceled {
+		t.Errorf("TransitiveClosure: %v", err)
+	}
+}
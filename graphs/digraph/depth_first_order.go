@@ -1,6 +1,12 @@
 package digraph
 
-import "github.com/youngzhu/algs4-go/fund"
+import (
+	"context"
+
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
 
 // Depth-first orders.
 // DFS search visits each vertex exactly once. Three vertex orderings are of
@@ -8,6 +14,11 @@ import "github.com/youngzhu/algs4-go/fund"
 // 1. Preorder: Put the vertex on a queue before the recursive calls
 // 2. Postorder: Put the vertex on a queue after the recursive calls
 // 3. Reverse postorder: Put the vertex on a stack after the recursive calls
+// The recursion is replaced by an explicit stack of the vertices whose
+// adjacency lists are being scanned: a vertex is in preorder when it is
+// pushed, and in postorder when it is popped, after all its adjacent
+// vertices, exactly as with the recursive calls. A long path does not need
+// a deep goroutine stack.
 
 type DepthFirstOrder struct {
 	marked []bool // marked[v]: has v marked
@@ -20,6 +31,23 @@ type DepthFirstOrder struct {
 }
 
 func NewDepthFirstOrder(g IDigraph) DepthFirstOrder {
+	dfo, _ := NewDepthFirstOrderContext(context.Background(), g, nil)
+	return dfo
+}
+
+// Same as NewDepthFirstOrder, but stops and returns ctx.Err() when ctx is
+// done, see graphs.Tracker. progress may be nil.
+func NewDepthFirstOrderContext(ctx context.Context, g IDigraph, progress graphs.Progress) (DepthFirstOrder, error) {
+	t := graphs.NewTracker(ctx, progress)
+	dfo := newDepthFirstOrder(g, t)
+	if err := t.Done(); err != nil {
+		return DepthFirstOrder{}, err
+	}
+	return dfo, nil
+}
+
+// the orders of all the vertices, until t says to stop
+func newDepthFirstOrder(g IDigraph, t *graphs.Tracker) DepthFirstOrder {
 	n := g.V()
 	marked := make([]bool, n)
 	pre := make([]int, n)
@@ -34,15 +62,40 @@ func NewDepthFirstOrder(g IDigraph) DepthFirstOrder {
 		preorder: preorder, 
 		postorder: postorder}
 
-	for v := 0; v < n; v++ {
+	for v := 0; v < n && t.OK(); v++ {
 		if !dfo.marked[v] {
-			dfo.dfs(g, v)
+			dfo.dfs(g, v, t)
 		}
 	}
 
 	return *dfo
 }
 
+// Computes the orders of the vertices reachable from vertex s only.
+// The preorder and postorder numbers of the other vertices are -1.
+func NewDepthFirstOrderFrom(g IDigraph, s int) DepthFirstOrder {
+	n := g.V()
+	if s < 0 || s >= n {
+		panic("invalidate vertex")
+	}
+	pre := make([]int, n)
+	post := make([]int, n)
+	for v := 0; v < n; v++ {
+		pre[v], post[v] = -1, -1
+	}
+
+	dfo := &DepthFirstOrder{
+		marked:    make([]bool, n),
+		pre:       pre,
+		post:      post,
+		preorder:  fund.NewQueue(),
+		postorder: fund.NewQueue()}
+
+	dfo.dfs(g, s, graphs.NewTracker(context.Background(), nil))
+
+	return *dfo
+}
+
 func NewDepthFirstOrderWeighted(g EdgeWeightedDigraph) DepthFirstOrder {
 	n := g.V()
 	marked := make([]bool, n)
@@ -67,38 +120,73 @@ func NewDepthFirstOrderWeighted(g EdgeWeightedDigraph) DepthFirstOrder {
 	return *dfo
 }
 
-func (dfo *DepthFirstOrder) dfs(g IDigraph, v int) {
-	dfo.marked[v] = true
-	dfo.pre[v] = dfo.preCounter
-	dfo.preCounter++
-	dfo.preorder.Enqueue(v)
+// dfsFrame is a vertex on the explicit DFS stack
+type dfsFrame struct {
+	v    int
+	adj  graph.AdjList // adjacency list of v
+	next int           // index in adj of the next vertex to look at
+}
 
-	for _, it := range g.Adj(v) {
-		w := it.(int)
+// edgeFrame is a vertex on the explicit DFS stack of an edge-weighted digraph
+type edgeFrame struct {
+	v    int
+	adj  fund.Iterator // edges incident from v
+	next int           // index in adj of the next edge to look at
+}
+
+func (dfo *DepthFirstOrder) dfs(g IDigraph, s int, t *graphs.Tracker) {
+	dfo.visitPre(s)
+	stack := []dfsFrame{{v: s, adj: graph.Adjacent(g, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			dfo.visitPost(f.v)
+			if !t.Visit() {
+				return
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
 		if !dfo.marked[w] {
-			dfo.dfs(g, w)
+			dfo.visitPre(w)
+			stack = append(stack, dfsFrame{v: w, adj: graph.Adjacent(g, w)})
 		}
 	}
+}
 
-	dfo.postorder.Enqueue(v)
-	dfo.post[v] = dfo.postCounter
-	dfo.postCounter++
+func (dfo *DepthFirstOrder) dfsWeighted(g EdgeWeightedDigraph, s int) {
+	dfo.visitPre(s)
+	stack := []edgeFrame{{v: s, adj: g.Adj(s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == len(f.adj) {
+			stack = stack[:len(stack)-1]
+			dfo.visitPost(f.v)
+			continue
+		}
+
+		w := f.adj[f.next].(*DirectedEdge).To()
+		f.next++
+		if !dfo.marked[w] {
+			dfo.visitPre(w)
+			stack = append(stack, edgeFrame{v: w, adj: g.Adj(w)})
+		}
+	}
 }
 
-func (dfo *DepthFirstOrder) dfsWeighted(g EdgeWeightedDigraph, v int) {
+func (dfo *DepthFirstOrder) visitPre(v int) {
 	dfo.marked[v] = true
 	dfo.pre[v] = dfo.preCounter
 	dfo.preCounter++
 	dfo.preorder.Enqueue(v)
+}
 
-	for _, it := range g.Adj(v) {
-		e := it.(*DirectedEdge)
-		w := e.To()
-		if !dfo.marked[w] {
-			dfo.dfsWeighted(g, w)
-		}
-	}
-
+func (dfo *DepthFirstOrder) visitPost(v int) {
 	dfo.postorder.Enqueue(v)
 	dfo.post[v] = dfo.postCounter
 	dfo.postCounter++
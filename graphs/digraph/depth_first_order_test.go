@@ -0,0 +1,72 @@
+package digraph
+
+import (
+	"reflect"
+	"runtime/debug"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// a path 0->1->2->...->(n-1) is as deep as a DFS can go
+func TestDepthFirstOrder_longPath(t *testing.T) {
+	const n = 1000000
+	g := NewDigraphN(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(v-1, v)
+	}
+
+	// a recursive search would need far more than this
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	dfo := NewDepthFirstOrder(g)
+	for _, v := range []int{0, n / 2, n - 1} {
+		if dfo.Pre(v) != v || dfo.Post(v) != n-1-v {
+			t.Errorf("vertex %d: pre %d, post %d", v, dfo.Pre(v), dfo.Post(v))
+		}
+	}
+
+	if scc := NewKosarajuSharirSCC(*g); scc.Count() != n {
+		t.Errorf("path: got %d strong components, want %d", scc.Count(), n)
+	}
+	g.AddEdge(n-1, 0)
+	if scc := NewKosarajuSharirSCC(*g); scc.Count() != 1 {
+		t.Errorf("cycle: got %d strong components, want 1", scc.Count())
+	}
+}
+
+// the explicit stack gives the same orders as recursion
+func TestDepthFirstOrder_recursive(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(50, 100)
+		dfo := NewDepthFirstOrder(g)
+
+		marked := make([]bool, g.V())
+		var preorder, postorder []interface{}
+		var dfs func(v int)
+		dfs = func(v int) {
+			marked[v] = true
+			preorder = append(preorder, v)
+			for _, w := range g.Adj(v) {
+				if !marked[w.(int)] {
+					dfs(w.(int))
+				}
+			}
+			postorder = append(postorder, v)
+		}
+		for v := 0; v < g.V(); v++ {
+			if !marked[v] {
+				dfs(v)
+			}
+		}
+
+		if !reflect.DeepEqual([]interface{}(dfo.Preorder()), preorder) ||
+			!reflect.DeepEqual([]interface{}(dfo.Postorder()), postorder) {
+			t.Fatalf("%v\npostorder: got %v, want %v", g, dfo.Postorder(), postorder)
+		}
+	}
+}
@@ -16,13 +16,19 @@ type IDigraph interface {
 	graph.IGraph
 }
 
+// Reversible is implemented by digraphs that compute their reverse in their
+// own representation, such as the CSR graphs of package csr
+type Reversible interface {
+	ReverseDigraph() IDigraph
+}
+
 // Use the adjacency-lists representation, where maintain a vertex-indexed array
 // of lists of the vertices connected by an edge to each vertex.
 type Digraph struct {
-	v        int         // number of vertices
-	e        int         // number of edges
-	adj      []*fund.Bag // adj[v]: adjacency list for vertex v
-	indegree []int       // indegree[v]: indegree of vertex v
+	v        int          // number of vertices
+	e        int          // number of edges
+	adj      []*fund.List // adj[v]: adjacency list for vertex v
+	indegree []int        // indegree[v]: indegree of vertex v
 }
 
 // New an empty digraph with v vertices
@@ -31,9 +37,9 @@ func NewDigraphN(v int) *Digraph {
 		panic("number of verties in a Digraph must be non-negative")
 	}
 
-	adj := make([]*fund.Bag, v)
+	adj := make([]*fund.List, v)
 	for i := 0; i < v; i++ {
-		adj[i] = fund.NewBag()
+		adj[i] = fund.NewList()
 	}
 
 	indegree := make([]int, v)
@@ -129,3 +135,72 @@ func (g *Digraph) validateVertex(v int) {
 		panic("invalidate vertex")
 	}
 }
+
+// HasEdge returns true if this digraph has the directed edge v->w
+func (g *Digraph) HasEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	return g.adj[v].Contains(w)
+}
+
+// RemoveEdge removes one directed edge v->w from this digraph.
+// Returns false if there is no such edge.
+func (g *Digraph) RemoveEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.adj[v].Remove(w) {
+		return false
+	}
+	g.indegree[w]--
+	g.e--
+	return true
+}
+
+// AddVertex adds an isolated vertex to this digraph and returns it
+func (g *Digraph) AddVertex() int {
+	g.adj = append(g.adj, fund.NewList())
+	g.indegree = append(g.indegree, 0)
+	g.v++
+	return g.v - 1
+}
+
+// RemoveVertex removes the vertex v and the edges incident from and to it.
+// The vertices stay numbered 0 to V-1: the last vertex takes the number v.
+// Returns the number the vertex now numbered v had before, which is v
+// itself if v was the last vertex.
+// Finding the edges pointing to v takes time proportional to V + E.
+func (g *Digraph) RemoveVertex(v int) int {
+	g.validateVertex(v)
+
+	for _, it := range g.adj[v].Iterator() {
+		g.indegree[it.(int)]--
+		g.e--
+	}
+	g.adj[v] = fund.NewList()
+	for u := 0; u < g.v; u++ {
+		for g.adj[u].Remove(v) {
+			g.e--
+		}
+	}
+
+	last := g.v - 1
+	if v != last {
+		// renumber the edges pointing to last, parallel edges included:
+		// Replace renames one edge at a time
+		for u := 0; u < g.v; u++ {
+			for {
+				if !g.adj[u].Replace(last, v) {
+					break
+				}
+			}
+		}
+		g.adj[v] = g.adj[last]
+		g.indegree[v] = g.indegree[last]
+	}
+
+	g.adj[last] = nil
+	g.adj = g.adj[:last]
+	g.indegree = g.indegree[:last]
+	g.v--
+	return last
+}
@@ -0,0 +1,322 @@
+package digraph
+
+import (
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/sorting/pq"
+)
+
+// Directed cycles.
+// DirectedCycle finds one directed cycle. AllDirectedCycles finds all the
+// elementary cycles (no repeated vertex), with Johnson's algorithm. It takes
+// the strong components that have a cycle (more than one vertex, or a
+// self-loop) in the order of their smallest vertex s. A search from s in the
+// component finds the cycles through s; then s is removed, and the strong
+// components of the rest of the component are taken in turn. The components
+// are found without copying the digraph: the vertices of the current one
+// are tagged.
+// A vertex on the current path is blocked, and stays blocked after the
+// search leaves it if it led to no cycle: it is only unblocked when one of
+// the vertices it leads to is. So the search never goes twice down a dead
+// end, and every component searched has a cycle through s: the time is
+// O((V+E)(C+1)) for C cycles. There can be exponentially many cycles, hence
+// the limit.
+// ShortestDirectedCycle finds a cycle through a given vertex with the fewest
+// edges, with a breadth-first search from the vertex back to itself.
+// The cycles are given as paths whose first and last vertices are the same.
+
+// Returns the elementary cycles of digraph g, at most limit of them, or all
+// of them if limit <= 0. A self-loop v->v is the cycle [v v]. The cycles are
+// in the order of their smallest vertex.
+func AllDirectedCycles(g IDigraph, limit int) [][]int {
+	n := g.V()
+	j := &johnson{
+		adj:     make([][]int, n),
+		tag:     make([]int, n),
+		blocked: make([]bool, n),
+		b:       make([]map[int]bool, n),
+		pre:     make([]int, n),
+		low:     make([]int, n),
+		onStack: make([]bool, n),
+		limit:   limit,
+	}
+	all := make([]int, n)
+	for v := 0; v < n; v++ {
+		j.adj[v] = adjacent(g, v)
+		j.tag[v] = 1
+		j.pre[v] = -1
+		all[v] = v
+	}
+	j.current = 1
+
+	// the components to search, by smallest vertex
+	queue := pq.NewMinPQ()
+	for _, c := range j.components(all) {
+		queue.Insert(c)
+	}
+	for !queue.IsEmpty() {
+		c := queue.Delete().(*component)
+		j.current++
+		for _, v := range c.vertices {
+			j.tag[v] = j.current
+		}
+
+		j.circuits(c.min)
+		if limit > 0 && len(j.cycles) >= limit {
+			break
+		}
+		for _, v := range c.vertices {
+			j.blocked[v] = false
+			j.b[v] = nil
+		}
+
+		// the rest of the component, without its smallest vertex
+		j.tag[c.min] = 0
+		rest := make([]int, 0, len(c.vertices)-1)
+		for _, v := range c.vertices {
+			if v != c.min {
+				rest = append(rest, v)
+			}
+		}
+		for _, sub := range j.components(rest) {
+			queue.Insert(sub)
+		}
+	}
+	return j.cycles
+}
+
+// a strong component with a cycle
+type component struct {
+	min      int // smallest vertex
+	vertices []int
+}
+
+func (c *component) CompareTo(x pq.Item) int {
+	return pq.IntItem(c.min).CompareTo(pq.IntItem(x.(*component).min))
+}
+
+// the state of Johnson's algorithm
+type johnson struct {
+	adj     [][]int
+	tag     []int // tag[v] == current: v is in the current component
+	current int
+	blocked []bool
+	b       []map[int]bool // b[w]: the vertices to unblock with w
+	pre     []int          // Tarjan's preorder numbers, -1 out of a search
+	low     []int
+	onStack []bool
+	limit   int
+	cycles  [][]int
+}
+
+func (j *johnson) inComponent(v int) bool {
+	return j.tag[v] == j.current
+}
+
+// Returns the strong components with a cycle of the subgraph induced by the
+// given vertices, which are the ones in the current component, with Tarjan's
+// algorithm (see TarjanSCC)
+func (j *johnson) components(vertices []int) []*component {
+	var found []*component
+	var stack []int // visited vertices not yet in a component
+	counter := 0
+	type frame struct {
+		v, next int
+	}
+	visit := func(v int) {
+		j.pre[v], j.low[v] = counter, counter
+		counter++
+		stack = append(stack, v)
+		j.onStack[v] = true
+	}
+
+	for _, r := range vertices {
+		if j.pre[r] >= 0 {
+			continue
+		}
+		visit(r)
+		dfs := []frame{{v: r}}
+		for len(dfs) > 0 {
+			f := &dfs[len(dfs)-1]
+			v := f.v
+			if f.next < len(j.adj[v]) {
+				w := j.adj[v][f.next]
+				f.next++
+				if !j.inComponent(w) {
+					continue
+				}
+				if j.pre[w] < 0 {
+					visit(w)
+					dfs = append(dfs, frame{v: w})
+				} else if j.onStack[w] && j.pre[w] < j.low[v] {
+					j.low[v] = j.pre[w]
+				}
+				continue
+			}
+
+			dfs = dfs[:len(dfs)-1]
+			if len(dfs) > 0 {
+				if u := dfs[len(dfs)-1].v; j.low[v] < j.low[u] {
+					j.low[u] = j.low[v]
+				}
+			}
+			if j.low[v] < j.pre[v] {
+				continue
+			}
+
+			// v is the root of a strong component
+			c := &component{min: v}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				j.onStack[w] = false
+				c.vertices = append(c.vertices, w)
+				if w < c.min {
+					c.min = w
+				}
+				if w == v {
+					break
+				}
+			}
+			if len(c.vertices) > 1 || j.hasSelfLoop(v) {
+				found = append(found, c)
+			}
+		}
+	}
+
+	for _, v := range vertices {
+		j.pre[v] = -1
+	}
+	return found
+}
+
+func (j *johnson) hasSelfLoop(v int) bool {
+	for _, w := range j.adj[v] {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
+
+// finds the cycles through s, in the current component
+func (j *johnson) circuits(s int) {
+	type frame struct {
+		v     int
+		adj   []int
+		next  int
+		found bool // has a cycle been found from v?
+	}
+
+	j.blocked[s] = true
+	path := []int{s}
+	stack := []frame{{v: s, adj: j.adj[s]}}
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next < len(f.adj) {
+			w := f.adj[f.next]
+			f.next++
+			if !j.inComponent(w) {
+				continue
+			}
+			if w == s {
+				cycle := append(append([]int(nil), path...), s)
+				j.cycles = append(j.cycles, cycle)
+				if j.limit > 0 && len(j.cycles) >= j.limit {
+					return
+				}
+				f.found = true
+			} else if !j.blocked[w] {
+				j.blocked[w] = true
+				path = append(path, w)
+				stack = append(stack, frame{v: w, adj: j.adj[w]})
+			}
+			continue
+		}
+
+		// all the edges from v are done
+		v, found := f.v, f.found
+		if found {
+			j.unblock(v)
+		} else {
+			for _, w := range f.adj {
+				if j.inComponent(w) {
+					if j.b[w] == nil {
+						j.b[w] = make(map[int]bool)
+					}
+					j.b[w][v] = true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		path = path[:len(path)-1]
+		if len(stack) > 0 && found {
+			stack[len(stack)-1].found = true
+		}
+	}
+}
+
+// unblocks v, and the vertices blocked because of it
+func (j *johnson) unblock(v int) {
+	stack := []int{v}
+	j.blocked[v] = false
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for w := range j.b[u] {
+			if j.blocked[w] {
+				j.blocked[w] = false
+				stack = append(stack, w)
+			}
+		}
+		j.b[u] = nil
+	}
+}
+
+// Returns a cycle through vertex s with the fewest edges,
+// or nil if s is on no cycle
+func ShortestDirectedCycle(g IDigraph, s int) []int {
+	if s < 0 || s >= g.V() {
+		panic("invalidate vertex")
+	}
+
+	marked := make([]bool, g.V())
+	edgeTo := make([]int, g.V())
+	queue := fund.NewQueue()
+	marked[s] = true
+	queue.Enqueue(s)
+
+	for !queue.IsEmpty() {
+		v := queue.Dequeue().(int)
+		for _, w := range adjacent(g, v) {
+			if w == s {
+				// s -> ... -> v -> s
+				var cycle []int
+				for x := v; x != s; x = edgeTo[x] {
+					cycle = append(cycle, x)
+				}
+				cycle = append(cycle, s)
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return append(cycle, s)
+			}
+			if !marked[w] {
+				marked[w] = true
+				edgeTo[w] = v
+				queue.Enqueue(w)
+			}
+		}
+	}
+	return nil
+}
+
+// returns the vertices adjacent from v
+func adjacent(g IDigraph, v int) []int {
+	adj := graph.Adjacent(g, v)
+	vertices := make([]int, adj.Len())
+	for i := range vertices {
+		vertices[i] = adj.At(i)
+	}
+	return vertices
+}
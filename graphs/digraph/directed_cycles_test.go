@@ -0,0 +1,168 @@
+package digraph
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestAllDirectedCycles_complete(t *testing.T) {
+	// a complete digraph on n vertices has sum C(n, k) (k-1)! cycles
+	g := NewDigraphN(5)
+	for v := 0; v < 5; v++ {
+		for w := 0; w < 5; w++ {
+			if v != w {
+				g.AddEdge(v, w)
+			}
+		}
+	}
+	want := 10*1 + 10*2 + 5*6 + 1*24
+	if cycles := AllDirectedCycles(g, 0); len(cycles) != want {
+		t.Errorf("got %d cycles, want %d", len(cycles), want)
+	}
+	if cycles := AllDirectedCycles(g, 7); len(cycles) != 7 {
+		t.Errorf("limit 7: got %d cycles", len(cycles))
+	}
+}
+
+func TestAllDirectedCycles_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(8, 5+i/5)
+		if i%10 == 0 {
+			g.AddEdge(i%8, i%8)
+		}
+		cycles := AllDirectedCycles(g, 0)
+
+		// each cycle once, as a rotation starting at its smallest vertex
+		seen := make(map[string]bool)
+		for _, cycle := range cycles {
+			checkCycle(t, g, cycle)
+			key := fmt.Sprint(cycle)
+			if seen[key] {
+				t.Fatalf("cycle %v found twice", cycle)
+			}
+			seen[key] = true
+		}
+		if want := countCycles(g); len(cycles) != want {
+			t.Fatalf("graph %d: got %d cycles, want %d", i, len(cycles), want)
+		}
+		if len(cycles) == 0 != NewTopological(g).IsDAG() {
+			t.Fatalf("graph %d: %d cycles", i, len(cycles))
+		}
+
+		for v := 0; v < g.V(); v++ {
+			shortest := ShortestDirectedCycle(g, v)
+			want := 0
+			for _, cycle := range cycles {
+				for _, x := range cycle {
+					if x == v && (want == 0 || len(cycle) < want) {
+						want = len(cycle)
+					}
+				}
+			}
+			if len(shortest) != want {
+				t.Fatalf("graph %d: shortest cycle through %d: %v, want length %d", i, v, shortest, want)
+			}
+			if shortest != nil {
+				checkCycle(t, g, shortest)
+			}
+		}
+
+		fas := NewFeedbackArcSet(g)
+		h := NewDigraphN(g.V())
+		removed := make(map[[2]int]int)
+		for _, e := range fas.Edges() {
+			removed[e]++
+		}
+		for v := 0; v < g.V(); v++ {
+			for _, x := range g.Adj(v) {
+				e := [2]int{v, x.(int)}
+				if removed[e] > 0 {
+					removed[e]--
+				} else {
+					h.AddEdge(v, x.(int))
+				}
+			}
+		}
+		if !NewTopological(h).IsDAG() {
+			t.Fatalf("graph %d: not a DAG without %v", i, fas.Edges())
+		}
+		if fas.Size() > g.E()/2+1 {
+			t.Fatalf("graph %d: %d edges of %d in the set", i, fas.Size(), g.E())
+		}
+	}
+}
+
+func checkCycle(t *testing.T, g *Digraph, cycle []int) {
+	t.Helper()
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("not a cycle: %v", cycle)
+	}
+	onCycle := make(map[int]bool)
+	for i := 1; i < len(cycle); i++ {
+		if onCycle[cycle[i]] {
+			t.Fatalf("cycle %v: %d repeated", cycle, cycle[i])
+		}
+		onCycle[cycle[i]] = true
+		if !g.HasEdge(cycle[i-1], cycle[i]) {
+			t.Fatalf("cycle %v: no edge %d->%d", cycle, cycle[i-1], cycle[i])
+		}
+	}
+}
+
+// counts the elementary cycles by brute force: the paths from s through
+// vertices > s back to s
+func countCycles(g *Digraph) int {
+	count := 0
+	onPath := make([]bool, g.V())
+	var extend func(s, v int)
+	extend = func(s, v int) {
+		for _, x := range g.Adj(v) {
+			w := x.(int)
+			if w == s {
+				count++
+			} else if w > s && !onPath[w] {
+				onPath[w] = true
+				extend(s, w)
+				onPath[w] = false
+			}
+		}
+	}
+	for s := 0; s < g.V(); s++ {
+		extend(s, s)
+	}
+	return count
+}
+
+// parallel edges make outdegree - indegree larger than V-1
+func TestFeedbackArcSet_parallelEdges(t *testing.T) {
+	g := NewDigraphN(2)
+	for i := 0; i < 5; i++ {
+		g.AddEdge(0, 1)
+	}
+	g.AddEdge(1, 0)
+
+	fas := NewFeedbackArcSet(g)
+	if fas.Size() != 1 || fas.Edges()[0] != [2]int{1, 0} {
+		t.Errorf("got %v, want [[1 0]]", fas.Edges())
+	}
+}
+
+// a DAG has no component to search: linear time, not one search per vertex
+func TestAllDirectedCycles_largeDAG(t *testing.T) {
+	g := NewDigraphN(200000)
+	for v := 0; v+1 < g.V(); v++ {
+		g.AddEdge(v, v+1)
+	}
+	g.AddEdge(g.V()-1, g.V()-1)
+
+	cycles := AllDirectedCycles(g, 0)
+	if len(cycles) != 1 || cycles[0][0] != g.V()-1 {
+		t.Errorf("got %d cycles, want the self-loop", len(cycles))
+	}
+}
@@ -0,0 +1,192 @@
+package digraph
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Directed Eulerian cycles and paths.
+// A digraph has an Eulerian cycle (a directed cycle that uses every edge
+// exactly once) if and only if every vertex has indegree equal to outdegree
+// and all the edges are in the same strong component; it has an Eulerian path
+// if and only if, in addition, at most one vertex has outdegree one more than
+// indegree (the start) and one has indegree one more than outdegree (the end).
+//
+// Hierholzer's algorithm, as for undirected graphs, but each edge is in the
+// adjacency list of one vertex only, so the edges need no marks. The reasons
+// for failure are the errors of package graph: ErrNoEdges,
+// ErrDegreeImbalance and ErrDisconnectedEdges.
+
+// Finds a directed Eulerian cycle in a digraph, if one exists
+type DirectedEulerianCycle struct {
+	cycle []int // Eulerian cycle (or nil if no such cycle)
+	err   error // why there is no Eulerian cycle
+}
+
+// Computes a directed Eulerian cycle in the digraph g, if one exists
+func NewDirectedEulerianCycle(g IDigraph) *DirectedEulerianCycle {
+	c := &DirectedEulerianCycle{}
+
+	// must have at least one edge
+	if g.E() == 0 {
+		c.err = graph.ErrNoEdges
+		return c
+	}
+
+	// necessary condition: indegree(v) = outdegree(v) for each vertex v
+	// (without this check, DFS might return a path instead of a cycle)
+	indegree := indegrees(g)
+	for v := 0; v < g.V(); v++ {
+		if out := len(g.Adj(v)); out != indegree[v] {
+			c.err = fmt.Errorf("%w: vertex %d has indegree %d and outdegree %d",
+				graph.ErrDegreeImbalance, v, indegree[v], out)
+			return c
+		}
+	}
+
+	c.cycle = directedEulerianTrail(g, nonIsolatedVertex(g))
+	if c.cycle == nil {
+		c.err = graph.ErrDisconnectedEdges
+	}
+
+	return c
+}
+
+// Returns the sequence of vertices on a directed Eulerian cycle, with the
+// first and the last vertex the same, or nil if no such cycle
+func (c *DirectedEulerianCycle) Cycle() []int {
+	return c.cycle
+}
+
+// Returns true if the digraph has a directed Eulerian cycle
+func (c *DirectedEulerianCycle) HasEulerianCycle() bool {
+	return c.cycle != nil
+}
+
+// Returns why the digraph has no directed Eulerian cycle,
+// or nil if it has one
+func (c *DirectedEulerianCycle) Err() error {
+	return c.err
+}
+
+// Finds a directed Eulerian path in a digraph, if one exists
+type DirectedEulerianPath struct {
+	path []int // Eulerian path (or nil if no such path)
+	err  error // why there is no Eulerian path
+}
+
+// Computes a directed Eulerian path in the digraph g, if one exists
+func NewDirectedEulerianPath(g IDigraph) *DirectedEulerianPath {
+	p := &DirectedEulerianPath{}
+
+	if g.V() == 0 {
+		p.err = graph.ErrNoEdges
+		return p
+	}
+
+	// find vertex from which to start potential Eulerian path:
+	// a vertex v with outdegree(v) > indegree(v) if it exists;
+	// otherwise a vertex with outdegree(v) > 0
+	indegree := indegrees(g)
+	deficit := 0
+	s := nonIsolatedVertex(g)
+	for v := 0; v < g.V(); v++ {
+		if out := len(g.Adj(v)); out > indegree[v] {
+			deficit += out - indegree[v]
+			s = v
+		}
+	}
+
+	// digraph can't have an Eulerian path
+	// (this condition is needed)
+	if deficit > 1 {
+		p.err = fmt.Errorf("%w: outdegree exceeds indegree by %d in total",
+			graph.ErrDegreeImbalance, deficit)
+		return p
+	}
+
+	// special case for digraph with zero edges (has a degenerate Eulerian path)
+	if s == -1 {
+		s = 0
+	}
+
+	p.path = directedEulerianTrail(g, s)
+	if p.path == nil {
+		p.err = graph.ErrDisconnectedEdges
+	}
+
+	return p
+}
+
+// Returns the sequence of vertices on a directed Eulerian path,
+// or nil if no such path
+func (p *DirectedEulerianPath) Path() []int {
+	return p.path
+}
+
+// Returns true if the digraph has a directed Eulerian path
+func (p *DirectedEulerianPath) HasEulerianPath() bool {
+	return p.path != nil
+}
+
+// Returns why the digraph has no directed Eulerian path,
+// or nil if it has one
+func (p *DirectedEulerianPath) Err() error {
+	return p.err
+}
+
+// Hierholzer's algorithm from vertex s. Returns nil if it does not use
+// every edge.
+func directedEulerianTrail(g IDigraph, s int) []int {
+	adj := make([][]interface{}, g.V())
+	for v := 0; v < g.V(); v++ {
+		adj[v] = g.Adj(v)
+	}
+	next := make([]int, g.V()) // next[v]: index in adj[v] of the next edge to follow
+
+	// greedily add to trail a vertex that has no more leaving edges
+	var trail []int
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for next[v] < len(adj[v]) {
+			w := adj[v][next[v]].(int)
+			next[v]++
+			stack = append(stack, v)
+			v = w
+		}
+		trail = append(trail, v)
+	}
+
+	// check if all edges are used
+	if len(trail) != g.E()+1 {
+		return nil
+	}
+
+	for i, j := 0, len(trail)-1; i < j; i, j = i+1, j-1 {
+		trail[i], trail[j] = trail[j], trail[i]
+	}
+	return trail
+}
+
+func indegrees(g IDigraph) []int {
+	indegree := make([]int, g.V())
+	for v := 0; v < g.V(); v++ {
+		for _, w := range g.Adj(v) {
+			indegree[w.(int)]++
+		}
+	}
+	return indegree
+}
+
+// returns any non-isolated vertex; -1 if no such vertex
+func nonIsolatedVertex(g IDigraph) int {
+	for v := 0; v < g.V(); v++ {
+		if len(g.Adj(v)) > 0 {
+			return v
+		}
+	}
+	return -1
+}
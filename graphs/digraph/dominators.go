@@ -0,0 +1,188 @@
+package digraph
+
+import "github.com/youngzhu/algs4-go/graphs/graph"
+
+// Dominators.
+// In a digraph with a root vertex, such as a control-flow graph with its
+// entry block, a vertex u dominates a vertex v if every path from the root to
+// v goes through u. The immediate dominator of v is the dominator of v, other
+// than v, that every other dominator of v dominates: the edges from the
+// immediate dominators form the dominator tree, rooted at the root.
+// Implements the iterative algorithm of Cooper, Harvey and Kennedy: the
+// vertices reachable from the root are numbered in reverse postorder (see
+// DepthFirstOrder), then the immediate dominator of each vertex is refined to
+// the nearest common ancestor, in the tree built so far, of its
+// predecessors, until nothing changes. A few passes are enough on the
+// digraphs of programs, and the code is simpler and often faster than
+// Lengauer-Tarjan's.
+// The dominance frontier of u is the set of vertices v such that u dominates
+// a predecessor of v, but does not strictly dominate v: where the paths from
+// u join other paths (the places of the phi functions of the SSA form).
+// The post-dominators are the dominators of the reverse digraph, from an
+// exit vertex: u post-dominates v if every path from v to the exit goes
+// through u.
+
+type Dominators struct {
+	root     int
+	idom     []int   // idom[v]: immediate dominator of v, -1 if none
+	rank     []int   // rank[v]: rank of v in reverse postorder, -1 if not reachable
+	frontier [][]int // frontier[v]: dominance frontier of v
+	tree     *Digraph
+	order    DepthFirstOrder // orders of the dominator tree
+}
+
+// Computes the dominators of the vertices of digraph g reachable from root
+func NewDominators(g IDigraph, root int) Dominators {
+	n := g.V()
+	dfo := NewDepthFirstOrderFrom(g, root)
+	d := Dominators{
+		root:     root,
+		idom:     make([]int, n),
+		rank:     make([]int, n),
+		frontier: make([][]int, n),
+	}
+
+	var order []int // reverse postorder
+	for v := range d.rank {
+		d.rank[v] = -1
+	}
+	for _, x := range dfo.ReversePostorder() {
+		v := x.(int)
+		d.rank[v] = len(order)
+		order = append(order, v)
+	}
+
+	// the predecessors of the vertices, reachable ones only
+	pred := make([][]int, n)
+	for _, v := range order {
+		adj := graph.Adjacent(g, v)
+		for i := 0; i < adj.Len(); i++ {
+			w := adj.At(i)
+			pred[w] = append(pred[w], v)
+		}
+	}
+
+	for v := range d.idom {
+		d.idom[v] = -1
+	}
+	d.idom[root] = root
+	for changed := true; changed; {
+		changed = false
+		for _, v := range order[1:] {
+			idom := -1
+			for _, p := range pred[v] {
+				if d.idom[p] == -1 {
+					// not processed yet
+					continue
+				}
+				if idom == -1 {
+					idom = p
+				} else {
+					idom = d.intersect(p, idom)
+				}
+			}
+			if d.idom[v] != idom {
+				d.idom[v] = idom
+				changed = true
+			}
+		}
+	}
+
+	// the frontiers: walk up from the predecessors of each join vertex, up
+	// to the root included for the root, which also joins the entry
+	d.idom[root] = -1
+	for _, v := range order {
+		if len(pred[v]) < 2 && v != root {
+			continue
+		}
+		for _, p := range pred[v] {
+			for runner := p; runner != d.idom[v]; runner = d.idom[runner] {
+				if f := d.frontier[runner]; len(f) > 0 && f[len(f)-1] == v {
+					break
+				}
+				d.frontier[runner] = append(d.frontier[runner], v)
+			}
+		}
+	}
+
+	d.tree = NewDigraphN(n)
+	for _, v := range order[1:] {
+		d.tree.AddEdge(d.idom[v], v)
+	}
+	d.order = NewDepthFirstOrderFrom(d.tree, root)
+
+	return d
+}
+
+// Computes the post-dominators of the vertices of digraph g that reach the
+// exit vertex: the dominators of the reverse of g from exit
+func NewPostDominators(g IDigraph, exit int) Dominators {
+	return NewDominators(reverse(g), exit)
+}
+
+// the nearest common ancestor of a and b in the tree built so far
+func (d Dominators) intersect(a, b int) int {
+	for a != b {
+		for d.rank[a] > d.rank[b] {
+			a = d.idom[a]
+		}
+		for d.rank[b] > d.rank[a] {
+			b = d.idom[b]
+		}
+	}
+	return a
+}
+
+// Returns the root vertex
+func (d Dominators) Root() int {
+	return d.root
+}
+
+// Is vertex v reachable from the root?
+func (d Dominators) Reachable(v int) bool {
+	d.validateVertex(v)
+	return d.rank[v] >= 0
+}
+
+// Returns the immediate dominator of vertex v,
+// or -1 if v is the root or is not reachable from the root
+func (d Dominators) Idom(v int) int {
+	d.validateVertex(v)
+	return d.idom[v]
+}
+
+// Returns the immediate dominators of all the vertices, see Idom
+func (d Dominators) Idoms() []int {
+	return append([]int(nil), d.idom...)
+}
+
+// Does vertex u dominate vertex v? A vertex dominates itself.
+// Returns false if u or v is not reachable from the root.
+func (d Dominators) Dominates(u, v int) bool {
+	d.validateVertex(u)
+	d.validateVertex(v)
+	if !d.Reachable(u) || !d.Reachable(v) {
+		return false
+	}
+	// u is an ancestor of v in the dominator tree
+	return d.order.Pre(u) <= d.order.Pre(v) && d.order.Post(v) <= d.order.Post(u)
+}
+
+// Returns the dominance frontier of vertex v
+func (d Dominators) Frontier(v int) []int {
+	d.validateVertex(v)
+	return append([]int(nil), d.frontier[v]...)
+}
+
+// Returns the dominator tree, a digraph with an edge from the immediate
+// dominator of each vertex to the vertex. The vertices that are not
+// reachable from the root are isolated.
+func (d Dominators) Tree() *Digraph {
+	return d.tree
+}
+
+func (d Dominators) validateVertex(v int) {
+	if v < 0 || v >= len(d.idom) {
+		panic("invalidate vertex")
+	}
+}
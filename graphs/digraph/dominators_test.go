@@ -0,0 +1,101 @@
+package digraph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// the dominators are those of the definition: u dominates v if v is not
+// reachable from the root without u
+func TestDominators_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(30, 20+i)
+		root := r.Intn(30)
+		d := NewDominators(g, root)
+		checkDominators(t, g, root, d)
+
+		exit := r.Intn(30)
+		checkDominators(t, g.Reverse(), exit, NewPostDominators(g, exit))
+	}
+}
+
+func checkDominators(t *testing.T, g *Digraph, root int, d Dominators) {
+	t.Helper()
+	n := g.V()
+	reachable := reachableWithout(g, root, -1)
+	dominates := make([][]bool, n) // dominates[u][v]: does u dominate v?
+	for u := 0; u < n; u++ {
+		without := reachableWithout(g, root, u)
+		dominates[u] = make([]bool, n)
+		for v := 0; v < n; v++ {
+			dominates[u][v] = reachable[u] && reachable[v] && !without[v]
+			if d.Dominates(u, v) != dominates[u][v] {
+				t.Fatalf("root %d: %d dominates %d: got %v", root, u, v, d.Dominates(u, v))
+			}
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if d.Reachable(v) != reachable[v] {
+			t.Fatalf("root %d: %d reachable: got %v", root, v, d.Reachable(v))
+		}
+		// the immediate dominator is the strict dominator dominated by the others
+		idom := -1
+		for u := 0; u < n; u++ {
+			if u != v && dominates[u][v] && (idom == -1 || dominates[idom][u]) {
+				idom = u
+			}
+		}
+		if d.Idom(v) != idom {
+			t.Fatalf("root %d: idom of %d: got %d, want %d", root, v, d.Idom(v), idom)
+		}
+
+		// v is in the frontier of u if u dominates a predecessor of v, but
+		// does not strictly dominate v
+		var frontier []int
+		for w := 0; w < n; w++ {
+			inFrontier := false
+			for p := 0; p < n; p++ {
+				if g.HasEdge(p, w) && dominates[v][p] && !(dominates[v][w] && v != w) {
+					inFrontier = true
+				}
+			}
+			if inFrontier {
+				frontier = append(frontier, w)
+			}
+		}
+		got := d.Frontier(v)
+		sort.Ints(got)
+		if !reflect.DeepEqual(got, frontier) && len(got)+len(frontier) > 0 {
+			t.Fatalf("root %d: frontier of %d: got %v, want %v", root, v, got, frontier)
+		}
+	}
+}
+
+// the vertices reachable from s without going through x
+func reachableWithout(g *Digraph, s, x int) []bool {
+	marked := make([]bool, g.V())
+	if s == x {
+		return marked
+	}
+	marked[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, it := range g.Adj(v) {
+			if w := it.(int); w != x && !marked[w] {
+				marked[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return marked
+}
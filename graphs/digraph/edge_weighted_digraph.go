@@ -4,15 +4,34 @@ import (
 	"fmt"
 
 	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/graph"
 	"github.com/youngzhu/algs4-go/testutil"
 )
 
+// IEdgeWeightedDigraph edge-weighted digraph
+type IEdgeWeightedDigraph interface {
+	V() int
+	E() int
+	AddEdge(e *DirectedEdge)
+	Adj(v int) fund.Iterator
+}
+
+// CompactWeightedAdj is implemented by edge-weighted digraphs that store the
+// edges incident from each vertex as slices of targets and weights, such as
+// the CSR graphs of package csr.
+type CompactWeightedAdj interface {
+	graph.CompactAdj
+	// Weights returns the weights of the edges incident from v,
+	// in the order of Targets(v). The slice must not be modified.
+	Weights(v int) []float64
+}
+
 // An edge-weighted digraph, implemented using adjacency lists.
 type EdgeWeightedDigraph struct {
-	vertices int         // number of vertices in this digraph
-	edges    int         // number of edges in this digraph
-	adj      []*fund.Bag // adj[v]: adjaceny list for vertex v
-	indegree []int       // indegree[v]: indegree of vertex v
+	vertices int          // number of vertices in this digraph
+	edges    int          // number of edges in this digraph
+	adj      []*fund.List // adj[v]: adjaceny list for vertex v
+	indegree []int        // indegree[v]: indegree of vertex v
 }
 
 // New an empty edge-weighted graph with n vertices and 0 edges
@@ -22,9 +41,9 @@ func NewEdgeWeightedDigraphN(n int) *EdgeWeightedDigraph {
 	}
 
 	indegree := make([]int, n)
-	adj := make([]*fund.Bag, n)
+	adj := make([]*fund.List, n)
 	for v := 0; v < n; v++ {
-		adj[v] = fund.NewBag()
+		adj[v] = fund.NewList()
 	}
 
 	return &EdgeWeightedDigraph{vertices: n, adj: adj, indegree: indegree}
@@ -40,9 +59,9 @@ func NewEdgeWeightedDigraphIn(in *testutil.In) *EdgeWeightedDigraph {
 		panic("Number of vertices must be non-negative")
 	}
 	indegree := make([]int, vertices)
-	adj := make([]*fund.Bag, vertices)
+	adj := make([]*fund.List, vertices)
 	for v := 0; v < vertices; v++ {
-		adj[v] = fund.NewBag()
+		adj[v] = fund.NewList()
 	}
 
 	edges := in.ReadInt()
@@ -138,3 +157,88 @@ func (g *EdgeWeightedDigraph) validateVertex(v int) {
 		panic("invalidate vertex")
 	}
 }
+
+// HasEdge returns true if this edge-weighted digraph has an edge v->w
+func (g *EdgeWeightedDigraph) HasEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	for _, edge := range g.adj[v].Iterator() {
+		if edge.(*DirectedEdge).To() == w {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveEdge removes the edge e from this edge-weighted digraph.
+// Returns false if e is not in the digraph.
+func (g *EdgeWeightedDigraph) RemoveEdge(e *DirectedEdge) bool {
+	v, w := e.From(), e.To()
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.adj[v].Remove(e) {
+		return false
+	}
+	g.indegree[w]--
+	g.edges--
+	return true
+}
+
+// AddVertex adds an isolated vertex to this edge-weighted digraph and returns it
+func (g *EdgeWeightedDigraph) AddVertex() int {
+	g.adj = append(g.adj, fund.NewList())
+	g.indegree = append(g.indegree, 0)
+	g.vertices++
+	return g.vertices - 1
+}
+
+// RemoveVertex removes the vertex v and the edges incident from and to it.
+// The vertices stay numbered 0 to V-1: the last vertex takes the number v,
+// and its edges are replaced by new edges with the new number.
+// Returns the number the vertex now numbered v had before, which is v
+// itself if v was the last vertex.
+// Finding the edges pointing to v takes time proportional to V + E.
+func (g *EdgeWeightedDigraph) RemoveVertex(v int) int {
+	g.validateVertex(v)
+
+	for _, edge := range g.adj[v].Iterator() {
+		g.indegree[edge.(*DirectedEdge).To()]--
+		g.edges--
+	}
+	g.adj[v] = fund.NewList()
+	toV := func(edge fund.Item) bool { return edge.(*DirectedEdge).To() == v }
+	for u := 0; u < g.vertices; u++ {
+		for g.adj[u].RemoveFunc(toV) {
+			g.edges--
+		}
+	}
+
+	last := g.vertices - 1
+	if v != last {
+		for u := 0; u < g.vertices; u++ {
+			for _, edge := range g.adj[u].Iterator() {
+				e := edge.(*DirectedEdge)
+				if e.From() == last || e.To() == last {
+					ne := NewDirectedEdge(renumber(e.From(), last, v), renumber(e.To(), last, v), e.Weight())
+					g.adj[u].Replace(e, ne)
+				}
+			}
+		}
+		g.adj[v] = g.adj[last]
+		g.indegree[v] = g.indegree[last]
+	}
+
+	g.adj[last] = nil
+	g.adj = g.adj[:last]
+	g.indegree = g.indegree[:last]
+	g.vertices--
+	return last
+}
+
+// returns x, or to if x is from
+func renumber(x, from, to int) int {
+	if x == from {
+		return to
+	}
+	return x
+}
@@ -2,6 +2,7 @@ package digraph_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/youngzhu/algs4-go/fund"
 	"github.com/youngzhu/algs4-go/graphs/digraph"
@@ -41,6 +42,39 @@ func ExampleDigraph() {
 	// 12: 9
 }
 
+func ExampleDigraph_RemoveVertex() {
+	in := testutil.NewInReadWords("testdata/tinyDG.txt")
+	g := digraph.NewDigraph(in)
+
+	g.RemoveEdge(6, 9)
+	// the last vertex, 12, is renumbered 4
+	moved := g.RemoveVertex(4)
+	fmt.Printf("vertex %d is now vertex 4\n", moved)
+
+	v := g.AddVertex()
+	g.AddEdge(v, 0)
+	fmt.Print(g)
+	fmt.Println(g.HasEdge(9, 4), g.Indegree(4), g.Indegree(0))
+
+	// Output:
+	// vertex 12 is now vertex 4
+	// 13 vertices, 17 edges
+	// 0: 5 1
+	// 1:
+	// 2: 0 3
+	// 3: 5 2
+	// 4: 9
+	// 5:
+	// 6: 8 0
+	// 7: 6 9
+	// 8: 6
+	// 9: 11 10
+	// 10: 4
+	// 11: 4
+	// 12: 0
+	// false 2 3
+}
+
 func ExampleDepthFirstSearch_singleSource() {
 	dfs := digraph.NewDirectedDFS(*tinyDigraph, 2)
 
@@ -287,9 +321,249 @@ func ExampleTopological() {
 
 }
 
+func ExampleTopologicalX() {
+	sg := digraph.NewSymbolDigraph("testdata/jobs.txt", "/")
+	topological := digraph.NewTopologicalX(sg.Digraph())
+
+	for _, v := range topological.Order() {
+		fmt.Println(sg.Name(v))
+	}
+
+	// Output:
+	// Introduction to CS
+	// Calculus
+	// Algorithms
+	// Advanced Programming
+	// Linear Algebra
+	// Databases
+	// Scientific Computing
+	// Theoretical CS
+	// Artificial Intelligence
+	// Computational Biology
+	// Machine Learning
+	// Robotics
+	// Neural Networks
+}
+
+func ExampleNewTopologicalXLex() {
+	in := testutil.NewInReadWords("testdata/tinyDAG.txt")
+	g := digraph.NewDigraph(in)
+
+	fmt.Println(digraph.NewTopologicalX(g).Order())
+	fmt.Println(digraph.NewTopologicalXLex(g).Order())
+
+	// Output:
+	// [2 8 0 3 7 1 5 6 9 4 11 10 12]
+	// [2 0 1 3 5 8 7 6 4 9 10 11 12]
+}
+
+// the jobs that can run in parallel
+func ExampleTopologicalX_Levels() {
+	sg := digraph.NewSymbolDigraph("testdata/jobs.txt", "/")
+	topological := digraph.NewTopologicalX(sg.Digraph())
+
+	for i, level := range topological.Levels() {
+		fmt.Printf("%d:", i)
+		for _, v := range level {
+			fmt.Printf(" [%s]", sg.Name(v))
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// 0: [Introduction to CS] [Calculus]
+	// 1: [Algorithms] [Advanced Programming] [Linear Algebra]
+	// 2: [Theoretical CS] [Databases] [Scientific Computing]
+	// 3: [Computational Biology] [Artificial Intelligence]
+	// 4: [Robotics] [Machine Learning]
+	// 5: [Neural Networks]
+}
+
+func ExampleTopologicalX_Cycle() {
+	topological := digraph.NewTopologicalX(tinyDigraph)
+
+	fmt.Println(topological.HasOrder(), topological.Cycle())
+
+	// Output:
+	// false [12 9 11 12]
+}
+
+func ExampleAllTopologicalOrders() {
+	g := digraph.NewDigraphN(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 1)
+	g.AddEdge(2, 3)
+
+	for _, order := range digraph.AllTopologicalOrders(g, 4) {
+		fmt.Println(order)
+	}
+
+	// Output:
+	// [0 2 1 3]
+	// [0 2 3 1]
+	// [2 0 1 3]
+	// [2 0 3 1]
+}
+
+func ExampleShortestCommonAncestor() {
+	// a rooted DAG, with root 0
+	g := digraph.NewDigraphN(8)
+	for _, e := range [][2]int{{1, 0}, {2, 0}, {3, 1}, {4, 1}, {5, 2}, {6, 5}, {7, 3}, {7, 5}} {
+		g.AddEdge(e[0], e[1])
+	}
+	sca := digraph.NewShortestCommonAncestor(g)
+
+	fmt.Println(sca.Ancestor(4, 6))
+	fmt.Println(sca.Ancestor(7, 6))
+	fmt.Println(sca.AncestorSubset([]int{4, 7}, []int{6, 2}))
+
+	// Output:
+	// 0 5
+	// 5 2
+	// 5 2
+}
+
+func ExampleWordNet() {
+	wn := digraph.NewWordNet("testdata/tinySynsets.txt", "testdata/tinyHypernyms.txt")
+
+	fmt.Println(len(wn.Nouns()), wn.IsNoun("eagle"), wn.IsNoun("Eagle"))
+	for _, pair := range [][2]string{{"cat", "dog"}, {"eagle", "oak"}, {"somebody", "dog"}, {"day", "year"}, {"cat", "year"}} {
+		fmt.Printf("%s, %s: %d, %s\n", pair[0], pair[1], wn.Distance(pair[0], pair[1]), wn.SAP(pair[0], pair[1]))
+	}
+
+	// Output:
+	// 54 true false
+	// cat, dog: 2, mammal mammalian
+	// eagle, oak: 7, organism being
+	// somebody, dog: 1, person individual someone somebody mortal soul
+	// day, year: 2, time_period period period_of_time
+	// cat, year: 11, entity
+}
+
+// the control-flow graph of a loop with an if-else inside:
+// 0 entry, 1 loop test, 2 then, 3 else, 4 end of the body, 5 exit
+func ExampleDominators() {
+	g := digraph.NewDigraphN(6)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {1, 3}, {1, 5}, {2, 4}, {3, 4}, {4, 1}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	d := digraph.NewDominators(g, 0)
+	fmt.Println("idoms:", d.Idoms())
+	for v := 0; v < g.V(); v++ {
+		fmt.Printf("frontier of %d: %v\n", v, d.Frontier(v))
+	}
+	fmt.Println(d.Dominates(1, 4), d.Dominates(2, 4))
+	fmt.Print(d.Tree())
+
+	pd := digraph.NewPostDominators(g, 5)
+	fmt.Println("post-idoms:", pd.Idoms())
+
+	// Output:
+	// idoms: [-1 0 1 1 1 1]
+	// frontier of 0: []
+	// frontier of 1: [1]
+	// frontier of 2: [4]
+	// frontier of 3: [4]
+	// frontier of 4: [1]
+	// frontier of 5: []
+	// true false
+	// 6 vertices, 5 edges
+	// 0: 1
+	// 1: 5 4 3 2
+	// 2:
+	// 3:
+	// 4:
+	// 5:
+	// post-idoms: [1 5 4 4 1 -1]
+}
+
+func ExampleAllDirectedCycles() {
+	sg := digraph.NewSymbolDigraph("testdata/dependencies.txt", "/")
+
+	for _, cycle := range digraph.AllDirectedCycles(sg.Digraph(), 0) {
+		fmt.Println(strings.Join(sg.Names(cycle), " -> "))
+	}
+
+	// Output:
+	// api -> log -> config -> cache -> api
+	// api -> cache -> api
+	// api -> auth -> crypto -> util -> log -> config -> cache -> api
+	// api -> auth -> db -> config -> cache -> api
+	// api -> auth -> db -> log -> config -> cache -> api
+	// cache -> db -> config -> cache
+	// cache -> db -> log -> config -> cache
+	// log -> config -> log
+	// crypto -> util -> crypto
+}
+
+func ExampleShortestDirectedCycle() {
+	cycle := digraph.ShortestDirectedCycle(tinyDigraph, 2)
+	fmt.Println(cycle)
+
+	// Output:
+	// [2 3 2]
+}
+
+// the dependencies to cut to be able to order the packages
+func ExampleFeedbackArcSet() {
+	sg := digraph.NewSymbolDigraph("testdata/dependencies.txt", "/")
+	g := sg.Digraph()
+
+	fas := digraph.NewFeedbackArcSet(g)
+	for _, e := range fas.Edges() {
+		fmt.Printf("%s -> %s\n", sg.Name(e[0]), sg.Name(e[1]))
+		g.RemoveEdge(e[0], e[1])
+	}
+	fmt.Println(sg.Names(digraph.NewTopological(g).Order()))
+
+	// Output:
+	// cache -> api
+	// cache -> db
+	// crypto -> util
+	// config -> log
+	// [util api auth db crypto log config cache]
+}
+
+func ExampleReadSymbolDigraphCSV() {
+	in := `Boston,"Washington, D.C.",New York
+"Washington, D.C.",Atlanta
+New York,Boston
+`
+	sg, err := digraph.ReadSymbolDigraphCSV(strings.NewReader(in), ',')
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sg.AddEdge("Atlanta", "Miami")
+
+	v, ok := sg.Lookup("Washington, D.C.")
+	fmt.Println(v, ok)
+	_, ok = sg.Lookup("Chicago")
+	fmt.Println(ok)
+	fmt.Print(sg.Digraph())
+
+	// a quote is missing
+	_, err = digraph.ReadSymbolDigraphCSV(strings.NewReader(`Boston,"New York`), ',')
+	fmt.Println(err != nil)
+
+	// Output:
+	// 1 true
+	// false
+	// 5 vertices, 5 edges
+	// 0: 2 1
+	// 1: 3
+	// 2: 0
+	// 3: 4
+	// 4:
+	// true
+}
+
 func sccExample(g digraph.Digraph) {
-	scc := digraph.NewKosarajuSharirSCC(g)
+	printComponents(&g, digraph.NewKosarajuSharirSCC(g))
+}
 
+func printComponents(g digraph.IDigraph, scc digraph.SCC) {
 	// number of connected components
 	n := scc.Count()
 	fmt.Printf("components: %d\n", n)
@@ -348,6 +622,121 @@ func ExampleKosarajuSharirSCC_mediumDG() {
 	// id-9: 10
 }
 
+func ExampleTarjanSCC() {
+	in := testutil.NewInReadWords("testdata/mediumDG.txt")
+	g := digraph.NewDigraph(in)
+
+	printComponents(g, digraph.NewTarjanSCC(g))
+
+	// Output:
+	// components: 10
+	// id-0: 21
+	// id-1: 2 5 6 8 9 11 12 13 15 16 18 19 22 23 25 26 28 29 30 31 32 33 34 35 37 38 39 40 42 43 44 46 47 48 49
+	// id-2: 41
+	// id-3: 7
+	// id-4: 0
+	// id-5: 14
+	// id-6: 45
+	// id-7: 1
+	// id-8: 3 4 17 20 24 27 36
+	// id-9: 10
+}
+
+func ExampleGabowSCC() {
+	in := testutil.NewInReadWords("testdata/mediumDG.txt")
+	g := digraph.NewDigraph(in)
+
+	printComponents(g, digraph.NewGabowSCC(g))
+
+	// Output:
+	// components: 10
+	// id-0: 21
+	// id-1: 2 5 6 8 9 11 12 13 15 16 18 19 22 23 25 26 28 29 30 31 32 33 34 35 37 38 39 40 42 43 44 46 47 48 49
+	// id-2: 41
+	// id-3: 7
+	// id-4: 0
+	// id-5: 14
+	// id-6: 45
+	// id-7: 1
+	// id-8: 3 4 17 20 24 27 36
+	// id-9: 10
+}
+
+// the components of a dependency graph, in topological order
+func ExampleCondensation() {
+	c := digraph.NewTarjanSCC(tinyDigraph).Condensation()
+	fmt.Print(c.DAG())
+
+	order := digraph.NewTopological(c.DAG()).Order()
+	for _, id := range order {
+		fmt.Printf("%d: size %d, members %v\n", id, c.Size(id), c.Members(id))
+	}
+
+	// Output:
+	// 5 vertices, 6 edges
+	// 0:
+	// 1: 0
+	// 2: 1
+	// 3: 1 2
+	// 4: 2 3
+	// 4: size 1, members [7]
+	// 3: size 2, members [6 8]
+	// 2: size 4, members [9 10 11 12]
+	// 1: size 5, members [0 2 3 4 5]
+	// 0: size 1, members [1]
+}
+
+func ExampleTransitiveClosure() {
+	tc := digraph.NewTransitiveClosure(tinyDigraph)
+
+	// print the closure as a matrix
+	fmt.Print("   ")
+	for w := 0; w < tinyDigraph.V(); w++ {
+		fmt.Printf("%3d", w)
+	}
+	fmt.Println()
+	for v := 0; v < tinyDigraph.V(); v++ {
+		fmt.Printf("%3d", v)
+		for w := 0; w < tinyDigraph.V(); w++ {
+			if tc.Reachable(v, w) {
+				fmt.Print("  T")
+			} else {
+				fmt.Print("  .")
+			}
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// 0  1  2  3  4  5  6  7  8  9 10 11 12
+	//   0  T  T  T  T  T  T  .  .  .  .  .  .  .
+	//   1  .  T  .  .  .  .  .  .  .  .  .  .  .
+	//   2  T  T  T  T  T  T  .  .  .  .  .  .  .
+	//   3  T  T  T  T  T  T  .  .  .  .  .  .  .
+	//   4  T  T  T  T  T  T  .  .  .  .  .  .  .
+	//   5  T  T  T  T  T  T  .  .  .  .  .  .  .
+	//   6  T  T  T  T  T  T  T  .  T  T  T  T  T
+	//   7  T  T  T  T  T  T  T  T  T  T  T  T  T
+	//   8  T  T  T  T  T  T  T  .  T  T  T  T  T
+	//   9  T  T  T  T  T  T  .  .  .  T  T  T  T
+	//  10  T  T  T  T  T  T  .  .  .  T  T  T  T
+	//  11  T  T  T  T  T  T  .  .  .  T  T  T  T
+	//  12  T  T  T  T  T  T  .  .  .  T  T  T  T
+}
+
+func ExampleReachabilityIndex() {
+	in := testutil.NewInReadWords("testdata/mediumDG.txt")
+	g := digraph.NewDigraph(in)
+	idx := digraph.NewReachabilityIndex(g, 0)
+
+	fmt.Println(idx.Reachable(0, 21), idx.Reachable(21, 0))
+	fmt.Println(idx.Reachable(10, 7), idx.Reachable(7, 10))
+
+	// Output:
+	// true false
+	// true false
+}
+
 func ExampleEdgeWeightedDigraph() {
 	in := testutil.NewInReadWords("testdata/tinyEWD.txt")
 	tinyEWD := digraph.NewEdgeWeightedDigraphIn(in)
@@ -365,3 +754,102 @@ func ExampleEdgeWeightedDigraph() {
 	// 6: 6->4  0.93 6->0  0.58 6->2  0.40
 	// 7: 7->3  0.39 7->5  0.28
 }
+
+func ExampleEdgeWeightedDigraph_RemoveVertex() {
+	in := testutil.NewInReadWords("testdata/tinyEWD.txt")
+	g := digraph.NewEdgeWeightedDigraphIn(in)
+
+	for _, e := range g.Adj(5) {
+		if e.(*digraph.DirectedEdge).To() == 1 {
+			g.RemoveEdge(e.(*digraph.DirectedEdge))
+		}
+	}
+	// the last vertex, 7, is renumbered 3
+	moved := g.RemoveVertex(3)
+	fmt.Printf("vertex %d is now vertex 3\n", moved)
+	fmt.Print(g)
+
+	// Output:
+	// vertex 7 is now vertex 3
+	// vertices:7, edges:11
+	// 0: 0->2  0.26 0->4  0.38
+	// 1:
+	// 2: 2->3  0.34
+	// 3: 3->5  0.28
+	// 4: 4->3  0.37 4->5  0.35
+	// 5: 5->3  0.28 5->4  0.35
+	// 6: 6->4  0.93 6->0  0.58 6->2  0.40
+}
+
+func ExampleDirectedEulerianCycle() {
+	g := digraph.NewDigraphN(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 2)
+	g.AddEdge(3, 3)
+
+	ec := digraph.NewDirectedEulerianCycle(g)
+	fmt.Println(ec.Cycle(), ec.Err())
+
+	ec = digraph.NewDirectedEulerianCycle(tinyDigraph)
+	fmt.Println(ec.HasEulerianCycle(), ec.Err())
+
+	// Output:
+	// [0 1 2 3 3 2 0] <nil>
+	// false degree imbalance: vertex 1 has indegree 1 and outdegree 0
+}
+
+func ExampleDirectedEulerianPath() {
+	g := digraph.NewDigraphN(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+
+	ep := digraph.NewDirectedEulerianPath(g)
+	fmt.Println(ep.Path(), ep.Err())
+
+	g.AddEdge(4, 4)
+	ep = digraph.NewDirectedEulerianPath(g)
+	fmt.Println(ep.HasEulerianPath(), ep.Err())
+
+	// Output:
+	// [0 1 2 0 3] <nil>
+	// false edges are not all connected
+}
+
+func ExampleDigraphGenerator() {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := digraph.NewDigraphGenerator(r)
+
+	dag := gen.DAG(50, 200)
+	fmt.Println("dag:", dag.E(), digraph.NewTopological(dag).HasOrder())
+
+	strong := gen.Strong(50, 200, 5)
+	fmt.Println("strong:", strong.E(), digraph.NewKosarajuSharirSCC(*strong).Count())
+
+	tournament := gen.Tournament(10)
+	fmt.Println("tournament:", tournament.E())
+
+	tree := gen.RootedInTree(10)
+	roots := 0
+	for v := 0; v < tree.V(); v++ {
+		if tree.Outdegree(v) == 0 {
+			roots++
+		}
+	}
+	fmt.Println("rooted in-tree:", tree.E(), roots)
+
+	eulerian := gen.EulerianCycle(10, 30)
+	fmt.Println("eulerian:", digraph.NewDirectedEulerianCycle(eulerian).HasEulerianCycle())
+
+	// Output:
+	// dag: 200 true
+	// strong: 200 5
+	// tournament: 45
+	// rooted in-tree: 9 1
+	// eulerian: true
+}
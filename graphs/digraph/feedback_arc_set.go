@@ -0,0 +1,155 @@
+package digraph
+
+// Feedback arc set.
+// A set of edges whose removal leaves a DAG. Finding a smallest one is
+// NP-hard; the heuristic of Eades, Lin and Smyth finds a small one in linear
+// time. It orders the vertices, and the edges that point backward in the
+// order are the feedback arc set. The order is built from both ends, as the
+// vertices are removed from the digraph:
+// 1. a sink goes at the end of the left part of the second half (s2);
+// 2. a source goes at the end of the first half (s1);
+// 3. otherwise, the vertex with the largest outdegree - indegree goes at the
+//    end of s1, as most of its edges point forward.
+// Sinks and sources add no backward edge.
+
+type FeedbackArcSet struct {
+	order []int    // order of the vertices: s1 followed by s2
+	edges [][2]int // the edges v->w pointing backward in the order
+}
+
+func NewFeedbackArcSet(g IDigraph) FeedbackArcSet {
+	n := g.V()
+	succ := make([][]int, n)
+	pred := make([][]int, n)
+	indegree := make([]int, n)
+	outdegree := make([]int, n)
+	m := 0 // number of edges, but self-loops
+	for v := 0; v < n; v++ {
+		succ[v] = adjacent(g, v)
+		for _, w := range succ[v] {
+			if w == v {
+				// a self-loop is always in the set, and does not count
+				continue
+			}
+			pred[w] = append(pred[w], v)
+			outdegree[v]++
+			indegree[w]++
+			m++
+		}
+	}
+
+	removed := make([]bool, n)
+	var s1, s2 []int // s2 in reverse order
+	var sinks, sources []int
+	// buckets[m+d]: vertices with outdegree - indegree = d, maybe stale.
+	// With parallel edges, d is not within n-1, but always within m.
+	buckets := make([][]int, 2*m+1)
+	maxBucket := 0
+	bucket := func(v int) {
+		b := m + outdegree[v] - indegree[v]
+		buckets[b] = append(buckets[b], v)
+		if b > maxBucket {
+			maxBucket = b
+		}
+	}
+	classify := func(v int) {
+		switch {
+		case outdegree[v] == 0:
+			sinks = append(sinks, v)
+		case indegree[v] == 0:
+			sources = append(sources, v)
+		default:
+			bucket(v)
+		}
+	}
+	for v := 0; v < n; v++ {
+		classify(v)
+	}
+
+	remove := func(v int) {
+		removed[v] = true
+		for _, w := range succ[v] {
+			if w != v && !removed[w] {
+				indegree[w]--
+				classify(w)
+			}
+		}
+		for _, u := range pred[v] {
+			if !removed[u] {
+				outdegree[u]--
+				classify(u)
+			}
+		}
+	}
+
+	for left := n; left > 0; left-- {
+		v := -1
+		// the lists may hold removed vertices, or vertices that changed
+		for v == -1 && len(sinks) > 0 {
+			v, sinks = sinks[len(sinks)-1], sinks[:len(sinks)-1]
+			if removed[v] || outdegree[v] != 0 {
+				v = -1
+			} else {
+				s2 = append(s2, v)
+			}
+		}
+		for v == -1 && len(sources) > 0 {
+			v, sources = sources[len(sources)-1], sources[:len(sources)-1]
+			if removed[v] || indegree[v] != 0 || outdegree[v] == 0 {
+				v = -1
+			} else {
+				s1 = append(s1, v)
+			}
+		}
+		for v == -1 {
+			b := buckets[maxBucket]
+			if len(b) == 0 {
+				maxBucket--
+				continue
+			}
+			v, buckets[maxBucket] = b[len(b)-1], b[:len(b)-1]
+			if removed[v] || m+outdegree[v]-indegree[v] != maxBucket {
+				v = -1
+			} else {
+				s1 = append(s1, v)
+			}
+		}
+		remove(v)
+	}
+
+	fas := FeedbackArcSet{order: s1}
+	for i := len(s2) - 1; i >= 0; i-- {
+		fas.order = append(fas.order, s2[i])
+	}
+	rank := make([]int, n)
+	for i, v := range fas.order {
+		rank[v] = i
+	}
+	for v := 0; v < n; v++ {
+		for _, w := range succ[v] {
+			if rank[w] <= rank[v] {
+				fas.edges = append(fas.edges, [2]int{v, w})
+			}
+		}
+	}
+
+	return fas
+}
+
+// Returns the edges of the feedback arc set, as pairs of vertices v->w.
+// A parallel edge is repeated.
+func (fas FeedbackArcSet) Edges() [][2]int {
+	return fas.edges
+}
+
+// Returns the number of edges in the feedback arc set
+func (fas FeedbackArcSet) Size() int {
+	return len(fas.edges)
+}
+
+// Returns an order of the vertices in which the edges of the feedback arc
+// set are the only ones pointing backward: a topological order of the DAG
+// left when they are removed
+func (fas FeedbackArcSet) Order() []int {
+	return fas.order
+}
@@ -0,0 +1,124 @@
+package digraph
+
+import "github.com/youngzhu/algs4-go/graphs/graph"
+
+// Gabow's (path-based) strong components algorithm.
+// A single depth-first search with two stacks: one of the visited vertices
+// that are not yet in a component, and one of the roots of the candidate
+// components on the current DFS path. An edge v->w to a visited vertex w that
+// is not yet in a component closes a cycle, and merges the candidates on the
+// path from w to v: the roots above w are popped. When the search leaves v
+// and v is still the top root, v is the root of a strong component, made of
+// the vertices above v on the first stack.
+// The components are numbered as they are found, sinks first, as in
+// KosarajuSharirSCC: for every edge v->w, Id(v) >= Id(w).
+// The search uses an explicit stack instead of recursion, see DepthFirstOrder.
+
+type GabowSCC struct {
+	digraph IDigraph
+	marked  []bool // marked[v]: has vertex v been visited?
+	id      []int  // id[v]: id of strong component containing v, -1 if none yet
+	pre     []int  // pre[v]: preorder number of v
+	counter int    // counter for preorder numbering
+	count   int    // number of strongly-connected components
+	stack1  []int  // visited vertices not yet in a component
+	stack2  []int  // roots of the candidate components on the DFS path
+}
+
+func NewGabowSCC(g IDigraph) GabowSCC {
+	n := g.V()
+	scc := &GabowSCC{
+		digraph: g,
+		marked:  make([]bool, n),
+		id:      make([]int, n),
+		pre:     make([]int, n),
+	}
+	for v := range scc.id {
+		scc.id[v] = -1
+	}
+
+	for v := 0; v < n; v++ {
+		if !scc.marked[v] {
+			scc.dfs(v)
+		}
+	}
+
+	return *scc
+}
+
+func (scc *GabowSCC) dfs(s int) {
+	scc.visit(s)
+	stack := []dfsFrame{{v: s, adj: graph.Adjacent(scc.digraph, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		v := f.v
+		if f.next < f.adj.Len() {
+			w := f.adj.At(f.next)
+			f.next++
+			if !scc.marked[w] {
+				scc.visit(w)
+				stack = append(stack, dfsFrame{v: w, adj: graph.Adjacent(scc.digraph, w)})
+			} else if scc.id[w] == -1 {
+				for scc.pre[scc.stack2[len(scc.stack2)-1]] > scc.pre[w] {
+					scc.stack2 = scc.stack2[:len(scc.stack2)-1]
+				}
+			}
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		if scc.stack2[len(scc.stack2)-1] != v {
+			continue
+		}
+
+		// v is the root of a strong component
+		scc.stack2 = scc.stack2[:len(scc.stack2)-1]
+		for {
+			w := scc.stack1[len(scc.stack1)-1]
+			scc.stack1 = scc.stack1[:len(scc.stack1)-1]
+			scc.id[w] = scc.count
+			if w == v {
+				break
+			}
+		}
+		scc.count++
+	}
+}
+
+func (scc *GabowSCC) visit(v int) {
+	scc.marked[v] = true
+	scc.pre[v] = scc.counter
+	scc.counter++
+	scc.stack1 = append(scc.stack1, v)
+	scc.stack2 = append(scc.stack2, v)
+}
+
+// Returns the number of strong components
+func (scc GabowSCC) Count() int {
+	return scc.count
+}
+
+// Are vertices v and w in the same strong component?
+func (scc GabowSCC) StronglyConnected(v, w int) bool {
+	scc.validateVertex(v)
+	scc.validateVertex(w)
+	return scc.id[v] == scc.id[w]
+}
+
+// Returns the component id of the strong component containing vertex v
+func (scc GabowSCC) Id(v int) int {
+	scc.validateVertex(v)
+	return scc.id[v]
+}
+
+// Returns the condensation of the digraph, see Condensation
+func (scc GabowSCC) Condensation() Condensation {
+	return NewCondensation(scc.digraph, scc)
+}
+
+func (scc GabowSCC) validateVertex(v int) {
+	if v < 0 || v >= len(scc.id) {
+		panic("invalidate vertex")
+	}
+}
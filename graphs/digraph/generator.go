@@ -0,0 +1,399 @@
+package digraph
+
+import "github.com/youngzhu/algs4-go/testutil"
+
+// Digraph generators.
+// Random and structured digraphs, to test and benchmark digraph algorithms
+// without reading a file. As for graph.GraphGenerator, all the randomness
+// comes from the testutil.Random of the generator, and the vertices of the
+// structured digraphs are in random order.
+
+type DigraphGenerator struct {
+	r *testutil.Random
+}
+
+// NewDigraphGenerator returns a generator using r for the random choices.
+// If r is nil, a new (time-seeded) testutil.Random is used.
+func NewDigraphGenerator(r *testutil.Random) *DigraphGenerator {
+	if r == nil {
+		r = testutil.NewRandom()
+	}
+	return &DigraphGenerator{r}
+}
+
+// Returns a random simple digraph containing v vertices and e edges,
+// i.e. no self-loops or parallel edges
+func (gen *DigraphGenerator) Simple(v, e int) *Digraph {
+	if e > v*(v-1) {
+		panic("too many edges")
+	}
+	if e < 0 {
+		panic("too few edges")
+	}
+
+	g := NewDigraphN(v)
+	set := make(map[[2]int]bool)
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		addSimpleEdge(g, set, x, y)
+	}
+	return g
+}
+
+// Returns a random simple digraph on v vertices, with an edge between any
+// two vertices with probability p. This is sometimes referred to as the
+// Erdős–Rényi random digraph model.
+func (gen *DigraphGenerator) ErdosRenyi(v int, p float64) *Digraph {
+	if p < 0.0 || p > 1.0 {
+		panic("probability must be between 0 and 1")
+	}
+
+	g := NewDigraphN(v)
+	for x := 0; x < v; x++ {
+		for y := 0; y < v; y++ {
+			if x != y && gen.r.Float64() < p {
+				g.AddEdge(x, y)
+			}
+		}
+	}
+	return g
+}
+
+// Returns the complete digraph on v vertices:
+// an edge in each direction between any two vertices
+func (gen *DigraphGenerator) Complete(v int) *Digraph {
+	return gen.ErdosRenyi(v, 1.0)
+}
+
+// Returns a random simple DAG containing v vertices and e edges.
+// Note: it is not uniformly selected at random among all such DAGs.
+func (gen *DigraphGenerator) DAG(v, e int) *Digraph {
+	if e > v*(v-1)/2 {
+		panic("too many edges")
+	}
+	if e < 0 {
+		panic("too few edges")
+	}
+
+	g := NewDigraphN(v)
+	set := make(map[[2]int]bool)
+	vertices := gen.r.Perm(v)
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		if x < y {
+			addSimpleEdge(g, set, vertices[x], vertices[y])
+		}
+	}
+	return g
+}
+
+// Returns a random tournament digraph on v vertices. A tournament digraph
+// is a digraph in which, for every pair of vertices, there is one and only
+// one directed edge connecting them.
+func (gen *DigraphGenerator) Tournament(v int) *Digraph {
+	g := NewDigraphN(v)
+	for x := 0; x < v; x++ {
+		for y := x + 1; y < v; y++ {
+			if gen.r.Intn(2) == 0 {
+				g.AddEdge(x, y)
+			} else {
+				g.AddEdge(y, x)
+			}
+		}
+	}
+	return g
+}
+
+// Returns a complete rooted-in DAG on v vertices. A rooted in-DAG is a DAG
+// in which there is a single vertex (the root) that every other vertex can
+// reach; a complete one has an edge between any two vertices.
+func (gen *DigraphGenerator) CompleteRootedInDAG(v int) *Digraph {
+	g := NewDigraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v; i++ {
+		for j := i + 1; j < v; j++ {
+			g.AddEdge(vertices[i], vertices[j])
+		}
+	}
+	return g
+}
+
+// Returns a random rooted-in DAG on v vertices and e edges
+func (gen *DigraphGenerator) RootedInDAG(v, e int) *Digraph {
+	if e > v*(v-1)/2 {
+		panic("too many edges")
+	}
+	if e < v-1 {
+		panic("too few edges")
+	}
+
+	g := NewDigraphN(v)
+	set := make(map[[2]int]bool)
+	vertices := gen.r.Perm(v)
+
+	// one edge pointing from each vertex, other than the root = vertices[v-1]
+	for x := 0; x < v-1; x++ {
+		y := gen.r.UniformIntRange(x+1, v)
+		addSimpleEdge(g, set, vertices[x], vertices[y])
+	}
+
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		if x < y {
+			addSimpleEdge(g, set, vertices[x], vertices[y])
+		}
+	}
+	return g
+}
+
+// Returns a complete rooted-out DAG on v vertices. A rooted out-DAG is a DAG
+// in which every vertex is reachable from a single vertex (the root).
+func (gen *DigraphGenerator) CompleteRootedOutDAG(v int) *Digraph {
+	g := NewDigraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v; i++ {
+		for j := i + 1; j < v; j++ {
+			g.AddEdge(vertices[j], vertices[i])
+		}
+	}
+	return g
+}
+
+// Returns a random rooted-out DAG on v vertices and e edges
+func (gen *DigraphGenerator) RootedOutDAG(v, e int) *Digraph {
+	if e > v*(v-1)/2 {
+		panic("too many edges")
+	}
+	if e < v-1 {
+		panic("too few edges")
+	}
+
+	g := NewDigraphN(v)
+	set := make(map[[2]int]bool)
+	vertices := gen.r.Perm(v)
+
+	// one edge pointing to each vertex, other than the root = vertices[v-1]
+	for x := 0; x < v-1; x++ {
+		y := gen.r.UniformIntRange(x+1, v)
+		addSimpleEdge(g, set, vertices[y], vertices[x])
+	}
+
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		if x < y {
+			addSimpleEdge(g, set, vertices[y], vertices[x])
+		}
+	}
+	return g
+}
+
+// Returns a random rooted-in tree on v vertices: every vertex other than
+// the root has exactly one edge, on the way to the root
+func (gen *DigraphGenerator) RootedInTree(v int) *Digraph {
+	return gen.RootedInDAG(v, v-1)
+}
+
+// Returns a random rooted-out tree on v vertices: every vertex other than
+// the root has exactly one edge pointing to it
+func (gen *DigraphGenerator) RootedOutTree(v int) *Digraph {
+	return gen.RootedOutDAG(v, v-1)
+}
+
+// Returns a path digraph on v vertices
+func (gen *DigraphGenerator) Path(v int) *Digraph {
+	g := NewDigraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	return g
+}
+
+// Returns a complete binary tree digraph on v vertices,
+// with edges pointing to the root
+func (gen *DigraphGenerator) BinaryTree(v int) *Digraph {
+	g := NewDigraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 1; i < v; i++ {
+		g.AddEdge(vertices[i], vertices[(i-1)/2])
+	}
+	return g
+}
+
+// Returns a cycle digraph on v vertices
+func (gen *DigraphGenerator) Cycle(v int) *Digraph {
+	g := NewDigraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	if v > 0 {
+		g.AddEdge(vertices[v-1], vertices[0])
+	}
+	return g
+}
+
+// Returns an Eulerian cycle digraph on v vertices with e edges: a random
+// closed walk of length e, which may repeat edges and use self-loops
+func (gen *DigraphGenerator) EulerianCycle(v, e int) *Digraph {
+	if e <= 0 {
+		panic("an Eulerian cycle must have at least one edge")
+	}
+	if v <= 0 {
+		panic("an Eulerian cycle must have at least one vertex")
+	}
+
+	g := NewDigraphN(v)
+	vertices := make([]int, e)
+	for i := range vertices {
+		vertices[i] = gen.r.UniformIntN(v)
+	}
+	for i := 0; i < e-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	g.AddEdge(vertices[e-1], vertices[0])
+	return g
+}
+
+// Returns an Eulerian path digraph on v vertices with e edges: a random walk
+// of length e, which may repeat edges and use self-loops
+func (gen *DigraphGenerator) EulerianPath(v, e int) *Digraph {
+	if e < 0 {
+		panic("negative number of edges")
+	}
+	if v <= 0 {
+		panic("an Eulerian path must have at least one vertex")
+	}
+
+	g := NewDigraphN(v)
+	vertices := make([]int, e+1)
+	for i := range vertices {
+		vertices[i] = gen.r.UniformIntN(v)
+	}
+	for i := 0; i < e; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	return g
+}
+
+// Returns a random simple digraph on v vertices and e edges with (at least)
+// c strong components. The vertices are randomly assigned integer labels
+// between 0 and c-1 (corresponding to strong components). Then, a strong
+// component is created among the vertices with the same label. Next, random
+// edges (either between two vertices with the same label or from a vertex
+// with a smaller label to a vertex with a larger label). The number of
+// components will be equal to the number of distinct labels that are
+// assigned to vertices.
+func (gen *DigraphGenerator) Strong(v, e, c int) *Digraph {
+	if c >= v || c <= 0 {
+		panic("number of components must be between 1 and v")
+	}
+	if e <= 2*(v-c) {
+		panic("number of edges must be at least 2(v-c)")
+	}
+	if e > v*(v-1)/2 {
+		panic("too many edges")
+	}
+
+	g := NewDigraphN(v)
+	set := make(map[[2]int]bool)
+
+	// the strong component of each vertex
+	label := make([]int, v)
+	for x := range label {
+		label[x] = gen.r.UniformIntN(c)
+	}
+
+	// make all vertices with label i a strong component by
+	// combining a rooted in-tree and a rooted out-tree
+	for i := 0; i < c; i++ {
+		var vertices []int
+		for x := 0; x < v; x++ {
+			if label[x] == i {
+				vertices = append(vertices, x)
+			}
+		}
+		gen.r.Shuffle(len(vertices), func(a, b int) {
+			vertices[a], vertices[b] = vertices[b], vertices[a]
+		})
+
+		// rooted-in tree with root = vertices[count-1]
+		for j := 0; j < len(vertices)-1; j++ {
+			k := gen.r.UniformIntRange(j+1, len(vertices))
+			addSimpleEdge(g, set, vertices[j], vertices[k])
+		}
+
+		// rooted-out tree with root = vertices[count-1]
+		for j := 0; j < len(vertices)-1; j++ {
+			k := gen.r.UniformIntRange(j+1, len(vertices))
+			addSimpleEdge(g, set, vertices[k], vertices[j])
+		}
+	}
+
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		if label[x] <= label[y] {
+			addSimpleEdge(g, set, x, y)
+		}
+	}
+	return g
+}
+
+// adds x->y to g, unless it is a self-loop or already in set
+func addSimpleEdge(g *Digraph, set map[[2]int]bool, x, y int) {
+	if x != y && !set[[2]int{x, y}] {
+		set[[2]int{x, y}] = true
+		g.AddEdge(x, y)
+	}
+}
+
+// Edge-weighted digraph generator.
+// Gives random weights to the edges of a digraph, for instance one made by a
+// DigraphGenerator sharing the same testutil.Random, or makes a random
+// edge-weighted digraph directly.
+
+type EdgeWeightedDigraphGenerator struct {
+	r *testutil.Random
+
+	Lo, Hi float64 // the weights are uniform in [Lo, Hi)
+}
+
+// NewEdgeWeightedDigraphGenerator returns a generator of weights uniform in
+// [0, 1), using r for the random choices.
+// If r is nil, a new (time-seeded) testutil.Random is used.
+func NewEdgeWeightedDigraphGenerator(r *testutil.Random) *EdgeWeightedDigraphGenerator {
+	if r == nil {
+		r = testutil.NewRandom()
+	}
+	return &EdgeWeightedDigraphGenerator{r: r, Lo: 0, Hi: 1}
+}
+
+// Returns an edge-weighted digraph with the edges of g, each with a random weight
+func (gen *EdgeWeightedDigraphGenerator) Weighted(g IDigraph) *EdgeWeightedDigraph {
+	ewd := NewEdgeWeightedDigraphN(g.V())
+	for v := 0; v < g.V(); v++ {
+		for _, w := range g.Adj(v) {
+			ewd.AddEdge(NewDirectedEdge(v, w.(int), gen.weight()))
+		}
+	}
+	return ewd
+}
+
+// Returns a random edge-weighted digraph with v vertices and e edges,
+// which may have self-loops and parallel edges
+func (gen *EdgeWeightedDigraphGenerator) Random(v, e int) *EdgeWeightedDigraph {
+	if e < 0 {
+		panic("number of edges must be non-negative")
+	}
+
+	g := NewEdgeWeightedDigraphN(v)
+	for i := 0; i < e; i++ {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		g.AddEdge(NewDirectedEdge(x, y, gen.weight()))
+	}
+	return g
+}
+
+func (gen *EdgeWeightedDigraphGenerator) weight() float64 {
+	return gen.Lo + (gen.Hi-gen.Lo)*gen.r.Float64()
+}
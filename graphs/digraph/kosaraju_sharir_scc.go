@@ -1,5 +1,12 @@
 package digraph
 
+import (
+	"context"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
 // Strong connectivity is an equivalence relation on the set of vertices:
 // Reflexive: Every vertex v is strongly connected to itself
 // Symmetric: If v is strongly connected to w, then w is strongly connected to v
@@ -11,17 +18,36 @@ package digraph
 // 2. Run standard DFS on G, but consider the unmarked vertices in the order 
 // just computed instead of the standard numerical order
 // 3. All vertices reached on a call to the recursive dfs() from New method
+// Both searches use an explicit stack instead of recursion, see DepthFirstOrder.
 
 type KosarajuSharirSCC struct {
-	digraph Digraph
+	digraph IDigraph
 	marked []bool // marked[v]: has vertex v been visited?
 	id []int // id[v]: id of strong component containing v
 	count int // number of strongly-connected components
 }
 
 func NewKosarajuSharirSCC(g Digraph) KosarajuSharirSCC {
+	return NewKosarajuSharirSCCOf(&g)
+}
+
+// Same as NewKosarajuSharirSCC, for any IDigraph, such as a CSR digraph
+func NewKosarajuSharirSCCOf(g IDigraph) KosarajuSharirSCC {
+	scc, _ := NewKosarajuSharirSCCContext(context.Background(), g, nil)
+	return scc
+}
+
+// Same as NewKosarajuSharirSCC, but stops and returns ctx.Err() when ctx is
+// done, see graphs.Tracker. progress may be nil; each vertex is counted
+// twice, once in each search.
+func NewKosarajuSharirSCCContext(ctx context.Context, g IDigraph, progress graphs.Progress) (KosarajuSharirSCC, error) {
+	t := graphs.NewTracker(ctx, progress)
+
 	// compute reverse postorder of reverse graph
-	dfo := NewDepthFirstOrder(g.Reverse())
+	dfo := newDepthFirstOrder(reverse(g), t)
+	if !t.OK() {
+		return KosarajuSharirSCC{}, t.Done()
+	}
 
 	marked := make([]bool, g.V())
 	id := make([]int, g.V())
@@ -32,21 +58,42 @@ func NewKosarajuSharirSCC(g Digraph) KosarajuSharirSCC {
 	for _, v := range dfo.ReversePostorder() {
 		i := v.(int)
 		if !scc.marked[i] {
-			scc.dfs(i)
+			scc.dfs(i, t)
 			scc.count++
 		}
+		if !t.OK() {
+			break
+		}
+	}
+	if err := t.Done(); err != nil {
+		return KosarajuSharirSCC{}, err
 	}
 
-	return *scc
+	return *scc, nil
 }
 
-func (scc *KosarajuSharirSCC) dfs(v int) {
-	scc.marked[v] = true
-	scc.id[v] = scc.count
-	for _, it := range scc.digraph.Adj(v) {
-		w := it.(int)
+// the search from s, until t says to stop
+func (scc *KosarajuSharirSCC) dfs(s int, t *graphs.Tracker) {
+	scc.marked[s] = true
+	scc.id[s] = scc.count
+	stack := []dfsFrame{{v: s, adj: graph.Adjacent(scc.digraph, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			if !t.Visit() {
+				return
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
 		if !scc.marked[w] {
-			scc.dfs(w)
+			scc.marked[w] = true
+			scc.id[w] = scc.count
+			stack = append(stack, dfsFrame{v: w, adj: graph.Adjacent(scc.digraph, w)})
 		}
 	}
 }
@@ -58,13 +105,43 @@ func (scc KosarajuSharirSCC) Count() int {
 
 // Are vertices v and w in the same strong component?
 func (scc KosarajuSharirSCC) StronglyConnected(v, w int) bool {
-	scc.digraph.validateVertex(v)
-	scc.digraph.validateVertex(w)
+	scc.validateVertex(v)
+	scc.validateVertex(w)
 	return scc.id[v] == scc.id[w]
 }
 
 // Returns the component id of the strong component containing vertex v
 func (scc KosarajuSharirSCC) Id(v int) int {
-	scc.digraph.validateVertex(v)
+	scc.validateVertex(v)
 	return scc.id[v]
-}
\ No newline at end of file
+}
+
+// Returns the condensation of the digraph, see Condensation
+func (scc KosarajuSharirSCC) Condensation() Condensation {
+	return NewCondensation(scc.digraph, scc)
+}
+
+func (scc KosarajuSharirSCC) validateVertex(v int) {
+	if v < 0 || v >= len(scc.id) {
+		panic("invalidate vertex")
+	}
+}
+
+// reverse returns the reverse of g, in the representation of g if g is a
+// Digraph or Reversible
+func reverse(g IDigraph) IDigraph {
+	switch g := g.(type) {
+	case *Digraph:
+		return g.Reverse()
+	case Reversible:
+		return g.ReverseDigraph()
+	}
+
+	r := NewDigraphN(g.V())
+	for v := 0; v < g.V(); v++ {
+		for _, w := range g.Adj(v) {
+			r.AddEdge(w.(int), v)
+		}
+	}
+	return r
+}
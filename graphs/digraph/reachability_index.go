@@ -0,0 +1,186 @@
+package digraph
+
+import "math/rand"
+
+// Reachability index.
+// Answers "is w reachable from v?" on large digraphs, in O(V+E) space instead
+// of the V² bits of TransitiveClosure. It is built in two steps:
+// 1. The strong components are contracted (see Condensation): the vertices
+//    of a component reach the same vertices, and the rest is a DAG.
+// 2. Each component c of the DAG gets k interval labels [low, post], one per
+//    depth-first traversal of the DAG, where the children are visited in a
+//    random order: post is the postorder number of c, and low the smallest
+//    postorder number of the components reachable from c (GRAIL, Yildirim,
+//    Chaoji and Zaki). If d is reachable from c, all the labels of d are
+//    contained in those of c.
+// A query first looks for a quick answer: components in the wrong order
+// (the ids are in reverse topological order), a label of the target not
+// contained in the label of the source (not reachable), or the target in the
+// DFS subtree of the source in the first traversal (reachable). Otherwise, it
+// searches the DAG from the source, skipping the components whose labels do
+// not contain the label of the target. Most queries are answered by the
+// labels alone, but a query may take O(V+E) time in the worst case.
+
+type ReachabilityIndex struct {
+	id     []int   // id[v]: component of vertex v
+	offset []int   // the children of c are target[offset[c]:offset[c+1]]
+	target []int32 // the children of the components in the DAG
+	low    [][]int // low[i][c]: low number of c in traversal i
+	post   [][]int // post[i][c]: postorder number of c in traversal i
+	pre    []int   // pre[c]: preorder number of c in traversal 0
+}
+
+// Builds the index of digraph g with k interval labels per component,
+// or 2 labels if k <= 0
+func NewReachabilityIndex(g IDigraph, k int) ReachabilityIndex {
+	if k <= 0 {
+		k = 2
+	}
+
+	scc := NewTarjanSCC(g)
+	c := NewCondensation(g, scc)
+	dag := c.DAG()
+	n := dag.V()
+
+	idx := ReachabilityIndex{
+		id:     c.id,
+		offset: make([]int, n+1),
+		low:    make([][]int, k),
+		post:   make([][]int, k),
+	}
+	idx.target = make([]int32, 0, dag.E())
+	for v := 0; v < n; v++ {
+		for _, w := range dag.Adj(v) {
+			idx.target = append(idx.target, int32(w.(int)))
+		}
+		idx.offset[v+1] = len(idx.target)
+	}
+
+	// the traversals are random, but the same for the same digraph
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < k; i++ {
+		idx.label(i, r)
+	}
+
+	return idx
+}
+
+// computes the labels of traversal i, in a random order of the children
+func (idx *ReachabilityIndex) label(i int, r *rand.Rand) {
+	n := len(idx.offset) - 1
+	pre, low, post := make([]int, n), make([]int, n), make([]int, n)
+	marked := make([]bool, n)
+	children := func(c int) []int32 {
+		return idx.target[idx.offset[c]:idx.offset[c+1]]
+	}
+	for c := 0; c < n; c++ {
+		adj := children(c)
+		r.Shuffle(len(adj), func(a, b int) { adj[a], adj[b] = adj[b], adj[a] })
+	}
+
+	type frame struct {
+		c    int
+		next int
+	}
+	preCounter, postCounter := 0, 0
+	for _, root := range r.Perm(n) {
+		if marked[root] {
+			continue
+		}
+		marked[root] = true
+		pre[root] = preCounter
+		preCounter++
+		stack := []frame{{c: root}}
+
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			adj := children(f.c)
+			if f.next < len(adj) {
+				d := int(adj[f.next])
+				f.next++
+				if !marked[d] {
+					marked[d] = true
+					pre[d] = preCounter
+					preCounter++
+					stack = append(stack, frame{c: d})
+				}
+				continue
+			}
+
+			// all the children have their labels
+			stack = stack[:len(stack)-1]
+			c := f.c
+			post[c] = postCounter
+			postCounter++
+			low[c] = post[c]
+			for _, d := range adj {
+				if low[d] < low[c] {
+					low[c] = low[d]
+				}
+			}
+		}
+	}
+
+	idx.low[i], idx.post[i] = low, post
+	if i == 0 {
+		idx.pre = pre
+	}
+}
+
+// Is there a directed path from vertex v to vertex w in the digraph?
+func (idx ReachabilityIndex) Reachable(v, w int) bool {
+	idx.validateVertex(v)
+	idx.validateVertex(w)
+
+	source, sink := idx.id[v], idx.id[w]
+	if source == sink {
+		return true
+	}
+	if source < sink || !idx.contains(source, sink) {
+		return false
+	}
+	if idx.inSubtree(source, sink) {
+		return true
+	}
+
+	// pruned search of the DAG
+	marked := map[int]bool{source: true}
+	stack := []int{source}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, x := range idx.target[idx.offset[c]:idx.offset[c+1]] {
+			d := int(x)
+			if d == sink || idx.inSubtree(d, sink) {
+				return true
+			}
+			if marked[d] || d < sink || !idx.contains(d, sink) {
+				continue
+			}
+			marked[d] = true
+			stack = append(stack, d)
+		}
+	}
+	return false
+}
+
+// are all the labels of component d contained in those of component c?
+func (idx ReachabilityIndex) contains(c, d int) bool {
+	for i := range idx.low {
+		if idx.low[i][d] < idx.low[i][c] || idx.post[i][d] > idx.post[i][c] {
+			return false
+		}
+	}
+	return true
+}
+
+// is component d in the DFS subtree of component c, in traversal 0?
+func (idx ReachabilityIndex) inSubtree(c, d int) bool {
+	return idx.pre[c] <= idx.pre[d] && idx.post[0][d] <= idx.post[0][c]
+}
+
+func (idx ReachabilityIndex) validateVertex(v int) {
+	if v < 0 || v >= len(idx.id) {
+		panic("invalidate vertex")
+	}
+}
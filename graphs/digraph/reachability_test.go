@@ -0,0 +1,50 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// TransitiveClosure and ReachabilityIndex agree with a search from every vertex
+func TestReachability_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	graphs := []*Digraph{}
+	for i := 0; i < 50; i++ {
+		graphs = append(graphs, gen.Simple(60, 40+2*i), gen.DAG(60, 40+2*i))
+	}
+
+	for i, g := range graphs {
+		tc := NewTransitiveClosure(g)
+		indexes := []ReachabilityIndex{
+			NewReachabilityIndex(g, 0),
+			NewReachabilityIndex(g, 1),
+			NewReachabilityIndex(g, 5),
+		}
+
+		for v := 0; v < g.V(); v++ {
+			dfs := NewDirectedDFS(*g, v)
+			count := 0
+			for w := 0; w < g.V(); w++ {
+				want := dfs.Marked(w)
+				if want {
+					count++
+				}
+				if got := tc.Reachable(v, w); got != want {
+					t.Fatalf("graph %d: closure: %d->%d: got %v, want %v", i, v, w, got, want)
+				}
+				for k, idx := range indexes {
+					if got := idx.Reachable(v, w); got != want {
+						t.Fatalf("graph %d: index %d: %d->%d: got %v, want %v", i, k, v, w, got, want)
+					}
+				}
+			}
+			if tc.Count(v) != count {
+				t.Fatalf("graph %d: %d reaches %d vertices, want %d", i, v, tc.Count(v), count)
+			}
+		}
+	}
+}
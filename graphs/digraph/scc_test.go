@@ -0,0 +1,116 @@
+package digraph
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+var sccAlgorithms = map[string]func(g IDigraph) SCC{
+	"Kosaraju": func(g IDigraph) SCC { return NewKosarajuSharirSCCOf(g) },
+	"Tarjan":   func(g IDigraph) SCC { return NewTarjanSCC(g) },
+	"Gabow":    func(g IDigraph) SCC { return NewGabowSCC(g) },
+}
+
+// the three algorithms find the same components, numbered sinks first
+func TestSCC_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(50, 30+i)
+		want := NewKosarajuSharirSCC(*g)
+
+		for name, newSCC := range sccAlgorithms {
+			scc := newSCC(g)
+			if scc.Count() != want.Count() {
+				t.Fatalf("%s: got %d components, want %d", name, scc.Count(), want.Count())
+			}
+			for v := 0; v < g.V(); v++ {
+				for _, x := range g.Adj(v) {
+					w := x.(int)
+					if (scc.Id(v) == scc.Id(w)) != want.StronglyConnected(v, w) {
+						t.Fatalf("%s: %d->%d: wrong components", name, v, w)
+					}
+					if scc.Id(v) < scc.Id(w) {
+						t.Fatalf("%s: %d->%d: component %d before %d", name, v, w, scc.Id(v), scc.Id(w))
+					}
+				}
+				for w := 0; w < g.V(); w++ {
+					if (scc.Id(v) == scc.Id(w)) != want.StronglyConnected(v, w) {
+						t.Fatalf("%s: %d, %d: wrong components", name, v, w)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSCC_longPath(t *testing.T) {
+	const n = 1000000
+	g := NewDigraphN(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(v-1, v)
+	}
+
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	for name, newSCC := range sccAlgorithms {
+		if scc := newSCC(g); scc.Count() != n {
+			t.Errorf("%s: path: got %d strong components, want %d", name, scc.Count(), n)
+		}
+	}
+	g.AddEdge(n-1, 0)
+	for name, newSCC := range sccAlgorithms {
+		if scc := newSCC(g); scc.Count() != 1 {
+			t.Errorf("%s: cycle: got %d strong components, want 1", name, scc.Count())
+		}
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(50, 30+i)
+		c := NewTarjanSCC(g).Condensation()
+		dag := c.DAG()
+
+		if !NewTopological(dag).IsDAG() {
+			t.Fatalf("condensation is not a DAG")
+		}
+
+		size := 0
+		for id := 0; id < c.Count(); id++ {
+			size += c.Size(id)
+			for _, v := range c.Members(id) {
+				if c.Id(v) != id {
+					t.Fatalf("vertex %d: in members of %d, id %d", v, id, c.Id(v))
+				}
+			}
+		}
+		if size != g.V() {
+			t.Fatalf("got %d members, want %d", size, g.V())
+		}
+
+		// every edge between components is in the DAG, once
+		edges := make(map[[2]int]bool)
+		for v := 0; v < g.V(); v++ {
+			for _, w := range g.Adj(v) {
+				if from, to := c.Id(v), c.Id(w.(int)); from != to {
+					edges[[2]int{from, to}] = true
+					if !dag.HasEdge(from, to) {
+						t.Fatalf("missing edge %d->%d", from, to)
+					}
+				}
+			}
+		}
+		if dag.E() != len(edges) {
+			t.Fatalf("got %d edges, want %d", dag.E(), len(edges))
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package digraph
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Shortest common ancestors (the SAP of the WordNet assignment).
+// In a rooted DAG, where every vertex reaches the root, a common ancestor of
+// two sets of vertices A and B is a vertex reachable from both, and a
+// shortest ancestral path is a shortest path from a vertex of A to a common
+// ancestor, followed by a shortest path from a vertex of B to it: the
+// ancestor minimizes the sum of the two distances.
+// The two breadth-first searches run in lockstep, one level of one search at
+// a time, always the search with the smaller distance: a search stops when
+// its distance is at least the shortest sum found, and the other one
+// continues alone. The searches only mark the vertices near the sets, and
+// only these are reset after a query. The results are cached.
+
+// the number of results kept: the cache is cleared when it is full
+const scaCacheSize = 1 << 16
+
+type ShortestCommonAncestor struct {
+	digraph IDigraph
+
+	mu     sync.Mutex     // guards the fields below: queries are serialized
+	distA  []int          // distA[v]: distance from A to v, -1 if not marked
+	distB  []int          // distB[v]: distance from B to v, -1 if not marked
+	marked []int          // the vertices to reset
+	cache  map[string]sca // results by query
+}
+
+type sca struct {
+	ancestor, length int
+}
+
+// Preprocesses the rooted DAG g, panics if g is not a rooted DAG
+func NewShortestCommonAncestor(g IDigraph) *ShortestCommonAncestor {
+	if !NewTopologicalX(g).HasOrder() {
+		panic("not a DAG")
+	}
+	roots := 0
+	for v := 0; v < g.V(); v++ {
+		if graph.Adjacent(g, v).Len() == 0 {
+			roots++
+		}
+	}
+	if roots != 1 {
+		panic("not a rooted DAG")
+	}
+
+	s := &ShortestCommonAncestor{
+		digraph: g,
+		distA:   make([]int, g.V()),
+		distB:   make([]int, g.V()),
+		cache:   make(map[string]sca),
+	}
+	for v := range s.distA {
+		s.distA[v] = -1
+		s.distB[v] = -1
+	}
+	return s
+}
+
+// Returns a shortest common ancestor of vertices v and w,
+// and the length of the shortest ancestral path
+func (s *ShortestCommonAncestor) Ancestor(v, w int) (ancestor, length int) {
+	return s.AncestorSubset([]int{v}, []int{w})
+}
+
+// Returns the length of the shortest ancestral path of vertices v and w
+func (s *ShortestCommonAncestor) Length(v, w int) int {
+	_, length := s.Ancestor(v, w)
+	return length
+}
+
+// Returns a shortest common ancestor of the vertex sets a and b,
+// and the length of the shortest ancestral path between them
+func (s *ShortestCommonAncestor) AncestorSubset(a, b []int) (ancestor, length int) {
+	s.validateVertices(a)
+	s.validateVertices(b)
+
+	key := cacheKey(a, b)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.cache[key]; ok {
+		return r.ancestor, r.length
+	}
+	r := s.search(a, b)
+	if len(s.cache) >= scaCacheSize {
+		s.cache = make(map[string]sca)
+	}
+	s.cache[key] = r
+	return r.ancestor, r.length
+}
+
+// Returns the length of the shortest ancestral path between the vertex sets
+// a and b
+func (s *ShortestCommonAncestor) LengthSubset(a, b []int) int {
+	_, length := s.AncestorSubset(a, b)
+	return length
+}
+
+// the lockstep breadth-first searches from a and b
+func (s *ShortestCommonAncestor) search(a, b []int) sca {
+	best := sca{-1, -1}
+	found := func(v int) {
+		if length := s.distA[v] + s.distB[v]; best.length == -1 || length < best.length {
+			best = sca{v, length}
+		}
+	}
+
+	var queueA, queueB []int
+	for _, v := range a {
+		if s.distA[v] == -1 {
+			s.mark(s.distA, v, 0)
+			queueA = append(queueA, v)
+		}
+	}
+	for _, v := range b {
+		if s.distB[v] == -1 {
+			s.mark(s.distB, v, 0)
+			queueB = append(queueB, v)
+			if s.distA[v] != -1 {
+				found(v)
+			}
+		}
+	}
+
+	// a search goes on while it may find a shorter path
+	goesOn := func(queue []int, dist []int) bool {
+		return len(queue) > 0 && (best.length == -1 || dist[queue[0]] < best.length)
+	}
+	for goesOn(queueA, s.distA) || goesOn(queueB, s.distB) {
+		// the search with the smaller distance expands one level
+		if !goesOn(queueB, s.distB) || goesOn(queueA, s.distA) && s.distA[queueA[0]] <= s.distB[queueB[0]] {
+			queueA = s.expand(queueA, s.distA, s.distB, found)
+		} else {
+			queueB = s.expand(queueB, s.distB, s.distA, found)
+		}
+	}
+
+	for _, v := range s.marked {
+		s.distA[v], s.distB[v] = -1, -1
+	}
+	s.marked = s.marked[:0]
+	return best
+}
+
+// expands the level at the front of queue, in the search with distances dist
+func (s *ShortestCommonAncestor) expand(queue []int, dist, other []int, found func(v int)) []int {
+	level := dist[queue[0]]
+	for len(queue) > 0 && dist[queue[0]] == level {
+		v := queue[0]
+		queue = queue[1:]
+		adj := graph.Adjacent(s.digraph, v)
+		for i := 0; i < adj.Len(); i++ {
+			w := adj.At(i)
+			if dist[w] == -1 {
+				s.mark(dist, w, level+1)
+				queue = append(queue, w)
+				if other[w] != -1 {
+					found(w)
+				}
+			}
+		}
+	}
+	return queue
+}
+
+func (s *ShortestCommonAncestor) mark(dist []int, v, d int) {
+	dist[v] = d
+	s.marked = append(s.marked, v)
+}
+
+func (s *ShortestCommonAncestor) validateVertices(vertices []int) {
+	if len(vertices) == 0 {
+		panic("zero vertices")
+	}
+	for _, v := range vertices {
+		if v < 0 || v >= s.digraph.V() {
+			panic("invalidate vertex")
+		}
+	}
+}
+
+// the key of a query, the same for the same sets in any order, as the
+// shortest common ancestors of a and b are those of b and a
+func cacheKey(a, b []int) string {
+	ka, kb := setKey(a), setKey(b)
+	if kb < ka {
+		ka, kb = kb, ka
+	}
+	return ka + "|" + kb
+}
+
+func setKey(vertices []int) string {
+	sorted := append([]int(nil), vertices...)
+	sort.Ints(sorted)
+	var sb strings.Builder
+	for i, v := range sorted {
+		if i > 0 && v == sorted[i-1] {
+			continue
+		}
+		sb.WriteString(strconv.Itoa(v))
+		sb.WriteByte(' ')
+	}
+	return sb.String()
+}
@@ -0,0 +1,88 @@
+package digraph
+
+import (
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// the lockstep searches find the lengths of searches from every vertex
+func TestShortestCommonAncestor_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 50; i++ {
+		n := 40
+		g := gen.RootedInDAG(n, n-1+i*4)
+		s := NewShortestCommonAncestor(g)
+
+		dist := make([][]int, n) // dist[v][w]: distance from v to w
+		for v := range dist {
+			dist[v] = NewBreadthFirstDirectedPaths(*g, v).distTo
+		}
+		minDist := func(set []int, x int) int {
+			d := graphs.InfinityDistance
+			for _, v := range set {
+				if dist[v][x] < d {
+					d = dist[v][x]
+				}
+			}
+			return d
+		}
+
+		for j := 0; j < 50; j++ {
+			a := []int{r.Intn(n)}
+			b := []int{r.Intn(n), r.Intn(n)}
+			if j%2 == 0 {
+				a = append(a, r.Intn(n))
+			}
+
+			want := graphs.InfinityDistance
+			for x := 0; x < n; x++ {
+				da, db := minDist(a, x), minDist(b, x)
+				if da != graphs.InfinityDistance && db != graphs.InfinityDistance && da+db < want {
+					want = da + db
+				}
+			}
+
+			// twice, the second time from the cache, and the other way round
+			for k := 0; k < 2; k++ {
+				ancestor, length := s.AncestorSubset(a, b)
+				if length != want || minDist(a, ancestor)+minDist(b, ancestor) != want {
+					t.Fatalf("graph %d, %v and %v: got ancestor %d, length %d, want length %d", i, a, b, ancestor, length, want)
+				}
+				a, b = b, a
+			}
+			if v := a[0]; s.Length(v, v) != 0 {
+				t.Fatalf("length of %d and itself: %d", v, s.Length(v, v))
+			}
+		}
+	}
+}
+
+func TestShortestCommonAncestor_notRooted(t *testing.T) {
+	for name, g := range map[string]*Digraph{
+		"cycle":     cycleDigraph(3),
+		"two roots": NewDigraphN(2),
+		"empty":     NewDigraphN(0),
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: no panic", name)
+				}
+			}()
+			NewShortestCommonAncestor(g)
+		}()
+	}
+}
+
+func cycleDigraph(n int) *Digraph {
+	g := NewDigraphN(n)
+	for v := 0; v < n; v++ {
+		g.AddEdge(v, (v+1)%n)
+	}
+	return g
+}
@@ -1,8 +1,9 @@
 package digraph
 
 import (
-	"strings"
+	"io"
 
+	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/searching"
 	"github.com/youngzhu/algs4-go/testutil"
 )
@@ -17,46 +18,77 @@ type SymbolDigraph struct {
 // Each line in the file contains the name of a vertex,
 // followed by a list of the names of the vertices adjacent to that vertex,
 // separated by the delimiter.
+// Panics if the file cannot be read, see ReadSymbolDigraph.
 func NewSymbolDigraph(filepath, delimiter string) SymbolDigraph {
-	st := searching.NewRedBlackBST()
-
-	// first pass builds the index by reading strings to associate
-	// distinct strings with an index
-	in := testutil.NewInReadLines(filepath)
-	for in.HasNext() {
-		slice := strings.Split(in.ReadLine(), delimiter)
-		for _, v := range slice {
-			key := searching.StringKey(v)
-			if !st.Contains(key) {
-				st.Put(key, st.Size())
-			}
-		}
+	r, err := testutil.NewReader(filepath)
+	if err != nil {
+		panic(err)
 	}
+	defer r.Close()
+	sg, err := ReadSymbolDigraph(r, delimiter)
+	if err != nil {
+		panic(err)
+	}
+	return *sg
+}
+
+// Reads a Symbol Digraph in the format of NewSymbolDigraph, in a single
+// pass: the vertices are numbered in the order their names first appear
+func ReadSymbolDigraph(r io.Reader, delimiter string) (*SymbolDigraph, error) {
+	sg := newSymbolDigraph()
+	if err := graphs.ReadSymbolLines(r, delimiter, sg.addLine); err != nil {
+		return nil, err
+	}
+	return sg, nil
+}
+
+// Reads a Symbol Digraph in CSV format, with the comma as delimiter: the
+// names may be quoted, see graphs.ReadSymbolCSV
+func ReadSymbolDigraphCSV(r io.Reader, comma rune) (*SymbolDigraph, error) {
+	sg := newSymbolDigraph()
+	if err := graphs.ReadSymbolCSV(r, comma, sg.addLine); err != nil {
+		return nil, err
+	}
+	return sg, nil
+}
+
+func newSymbolDigraph() *SymbolDigraph {
+	return &SymbolDigraph{*searching.NewRedBlackBST(), nil, NewDigraphN(0)}
+}
 
-	// inverted index to get string keys in an array
-	keys := make([]string, st.Size())
-	for _, k := range st.Keys() {
-		i := st.Get(k).(int)
-		keys[i] = string(k.(searching.StringKey))
+// connects the first vertex on a line to all others
+func (sg *SymbolDigraph) addLine(names []string) {
+	v := sg.AddVertex(names[0])
+	for _, name := range names[1:] {
+		sg.digraph.AddEdge(v, sg.AddVertex(name))
 	}
+}
 
-	// second pass builds the digraph by connecting first vertex
-	// on each line to all others
-	digraph := NewDigraphN(st.Size())
-	in = testutil.NewIn(filepath)
-	for in.HasNext() {
-		slice := strings.Split(in.ReadLine(), delimiter)
-		key := searching.StringKey(slice[0])
-		v := st.Get(key).(int) // first vertex
-		var w int
-		for _, str := range slice[1:] {
-			key = searching.StringKey(str)
-			w = st.Get(key).(int)
-			digraph.AddEdge(v, w)
-		}
+// Adds a vertex named s, if there is none, and returns its index
+func (sg *SymbolDigraph) AddVertex(s string) int {
+	if v, ok := sg.Lookup(s); ok {
+		return v
 	}
+	v := sg.digraph.AddVertex()
+	sg.st.Put(searching.StringKey(s), v)
+	sg.keys = append(sg.keys, s)
+	return v
+}
 
-	return SymbolDigraph{*st, keys, digraph}
+// Adds the edge from the vertex named v to the vertex named w, and the
+// vertices if there are none
+func (sg *SymbolDigraph) AddEdge(v, w string) {
+	sg.digraph.AddEdge(sg.AddVertex(v), sg.AddVertex(w))
+}
+
+// Returns the integer associated with the vertex named s,
+// and false if there is no such vertex
+func (sg SymbolDigraph) Lookup(s string) (int, bool) {
+	v := sg.st.Get(searching.StringKey(s))
+	if v == nil {
+		return -1, false
+	}
+	return v.(int), true
 }
 
 // Does the digraph contain the vertex named s
@@ -65,7 +97,8 @@ func (sg SymbolDigraph) Contains(s string) bool {
 	return sg.st.Contains(key)
 }
 
-// Returns the integer associated with the vertex named s
+// Returns the integer associated with the vertex named s.
+// Panics if there is no such vertex, see Lookup.
 func (sg SymbolDigraph) Index(s string) int {
 	key := searching.StringKey(s)
 	return sg.st.Get(key).(int)
@@ -77,6 +110,15 @@ func (sg SymbolDigraph) Name(v int) string {
 	return sg.keys[v]
 }
 
+// Returns the names of the vertices, as for a path or a cycle
+func (sg SymbolDigraph) Names(vertices []int) []string {
+	names := make([]string, len(vertices))
+	for i, v := range vertices {
+		names[i] = sg.Name(v)
+	}
+	return names
+}
+
 // Returns the digraph associated with the symbol digraph
 func (sg SymbolDigraph) Digraph() *Digraph {
 	return sg.digraph
@@ -0,0 +1,143 @@
+package digraph
+
+import (
+	"context"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Tarjan's strong components algorithm.
+// A single depth-first search: low[v] is the smallest preorder number of a
+// vertex reachable from v through the DFS subtree of v and at most one more
+// edge to a vertex that is not yet in a component. When the search leaves v
+// and low[v] is the preorder number of v, v is the root of a strong
+// component, made of the vertices above v on a stack of visited vertices.
+// The components are numbered as they are found, sinks first, as in
+// KosarajuSharirSCC: for every edge v->w, Id(v) >= Id(w).
+// The search uses an explicit stack instead of recursion, see DepthFirstOrder.
+
+type TarjanSCC struct {
+	digraph IDigraph
+	marked  []bool // marked[v]: has vertex v been visited?
+	id      []int  // id[v]: id of strong component containing v
+	pre     []int  // pre[v]: preorder number of v
+	low     []int  // low[v]: low number of v
+	counter int    // counter for preorder numbering
+	count   int    // number of strongly-connected components
+	stack   []int  // visited vertices not yet in a component
+}
+
+func NewTarjanSCC(g IDigraph) TarjanSCC {
+	scc, _ := NewTarjanSCCContext(context.Background(), g, nil)
+	return scc
+}
+
+// Same as NewTarjanSCC, but stops and returns ctx.Err() when ctx is done, see
+// graphs.Tracker. progress may be nil.
+func NewTarjanSCCContext(ctx context.Context, g IDigraph, progress graphs.Progress) (TarjanSCC, error) {
+	n := g.V()
+	scc := &TarjanSCC{
+		digraph: g,
+		marked:  make([]bool, n),
+		id:      make([]int, n),
+		pre:     make([]int, n),
+		low:     make([]int, n),
+	}
+
+	t := graphs.NewTracker(ctx, progress)
+	for v := 0; v < n && t.OK(); v++ {
+		if !scc.marked[v] {
+			scc.dfs(v, t)
+		}
+	}
+	if err := t.Done(); err != nil {
+		return TarjanSCC{}, err
+	}
+
+	return *scc, nil
+}
+
+// the search from s, until t says to stop
+func (scc *TarjanSCC) dfs(s int, t *graphs.Tracker) {
+	scc.visit(s)
+	stack := []dfsFrame{{v: s, adj: graph.Adjacent(scc.digraph, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		v := f.v
+		if f.next < f.adj.Len() {
+			w := f.adj.At(f.next)
+			f.next++
+			if !scc.marked[w] {
+				scc.visit(w)
+				stack = append(stack, dfsFrame{v: w, adj: graph.Adjacent(scc.digraph, w)})
+			} else if scc.low[w] < scc.low[v] {
+				scc.low[v] = scc.low[w]
+			}
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		if !t.Visit() {
+			return
+		}
+		if len(stack) > 0 {
+			if u := stack[len(stack)-1].v; scc.low[v] < scc.low[u] {
+				scc.low[u] = scc.low[v]
+			}
+		}
+		if scc.low[v] < scc.pre[v] {
+			continue
+		}
+
+		// v is the root of a strong component
+		for {
+			w := scc.stack[len(scc.stack)-1]
+			scc.stack = scc.stack[:len(scc.stack)-1]
+			scc.id[w] = scc.count
+			scc.low[w] = len(scc.id) // w no longer lowers the low numbers of others
+			if w == v {
+				break
+			}
+		}
+		scc.count++
+	}
+}
+
+func (scc *TarjanSCC) visit(v int) {
+	scc.marked[v] = true
+	scc.pre[v] = scc.counter
+	scc.low[v] = scc.counter
+	scc.counter++
+	scc.stack = append(scc.stack, v)
+}
+
+// Returns the number of strong components
+func (scc TarjanSCC) Count() int {
+	return scc.count
+}
+
+// Are vertices v and w in the same strong component?
+func (scc TarjanSCC) StronglyConnected(v, w int) bool {
+	scc.validateVertex(v)
+	scc.validateVertex(w)
+	return scc.id[v] == scc.id[w]
+}
+
+// Returns the component id of the strong component containing vertex v
+func (scc TarjanSCC) Id(v int) int {
+	scc.validateVertex(v)
+	return scc.id[v]
+}
+
+// Returns the condensation of the digraph, see Condensation
+func (scc TarjanSCC) Condensation() Condensation {
+	return NewCondensation(scc.digraph, scc)
+}
+
+func (scc TarjanSCC) validateVertex(v int) {
+	if v < 0 || v >= len(scc.id) {
+		panic("invalidate vertex")
+	}
+}
@@ -0,0 +1,244 @@
+package digraph
+
+import (
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/sorting/pq"
+)
+
+// Topological sort with a queue (Kahn's algorithm).
+// The vertices of indegree 0 can come first: put them on a queue, then
+// repeatedly take a vertex from the queue, append it to the order and remove
+// its edges, which puts on the queue the vertices whose indegree drops to 0.
+// Every vertex gets in the order if and only if the digraph is a DAG.
+// Otherwise, every vertex left has an edge from another vertex left, and
+// following these edges backwards leads to a directed cycle, which is kept
+// to explain why there is no order.
+// With a minimum priority queue instead of a queue, the smallest vertex
+// available always comes first, which gives the lexicographically smallest
+// topological order, in time proportional to E + V log V.
+// The level of a vertex is the length of a longest path ending at the
+// vertex: the vertices of the same level are independent, and each level
+// only depends on the previous ones, so they can be processed in parallel
+// waves.
+
+type TopologicalX struct {
+	order []int // topological order, nil if none
+	rank  []int // rank[v]: rank of vertex v in order
+	level []int // level[v]: level of vertex v
+	cycle []int // directed cycle, if no order
+}
+
+// Computes a topological order of digraph g in the order of a FIFO queue
+func NewTopologicalX(g IDigraph) TopologicalX {
+	queue := fund.NewQueue()
+	return newTopologicalX(g,
+		func(v int) { queue.Enqueue(v) },
+		func() int { return queue.Dequeue().(int) },
+		queue.IsEmpty)
+}
+
+// Computes the lexicographically smallest topological order of digraph g
+func NewTopologicalXLex(g IDigraph) TopologicalX {
+	minPQ := pq.NewMinPQ()
+	return newTopologicalX(g,
+		func(v int) { minPQ.Insert(pq.IntItem(v)) },
+		func() int { return int(minPQ.Delete().(pq.IntItem)) },
+		minPQ.IsEmpty)
+}
+
+func newTopologicalX(g IDigraph, put func(v int), take func() int, isEmpty func() bool) TopologicalX {
+	n := g.V()
+	indegree := indegrees(g)
+	t := TopologicalX{rank: make([]int, n), level: make([]int, n)}
+
+	for v := 0; v < n; v++ {
+		if indegree[v] == 0 {
+			put(v)
+		}
+	}
+
+	order := make([]int, 0, n)
+	for !isEmpty() {
+		v := take()
+		t.rank[v] = len(order)
+		order = append(order, v)
+		adj := graph.Adjacent(g, v)
+		for i := 0; i < adj.Len(); i++ {
+			w := adj.At(i)
+			if t.level[v]+1 > t.level[w] {
+				t.level[w] = t.level[v] + 1
+			}
+			indegree[w]--
+			if indegree[w] == 0 {
+				put(w)
+			}
+		}
+	}
+
+	if len(order) == n {
+		t.order = order
+	} else {
+		t.cycle = leftCycle(g, indegree)
+	}
+	return t
+}
+
+// returns a directed cycle among the vertices left by Kahn's algorithm,
+// those with indegree > 0
+func leftCycle(g IDigraph, indegree []int) []int {
+	edgeTo := make([]int, g.V()) // edgeTo[w]: a vertex left with an edge to w
+	start := -1
+	for v := range indegree {
+		if indegree[v] == 0 {
+			continue
+		}
+		start = v
+		adj := graph.Adjacent(g, v)
+		for i := 0; i < adj.Len(); i++ {
+			edgeTo[adj.At(i)] = v
+		}
+	}
+
+	// go backwards until a vertex repeats
+	onPath := make(map[int]bool)
+	x := start
+	for !onPath[x] {
+		onPath[x] = true
+		x = edgeTo[x]
+	}
+
+	// x is on the cycle: x <- edgeTo[x] <- ... <- x
+	cycle := []int{x}
+	for y := edgeTo[x]; y != x; y = edgeTo[y] {
+		cycle = append(cycle, y)
+	}
+	cycle = append(cycle, x)
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
+
+// Returns a topological order if the digraph has a topologial order
+func (t TopologicalX) Order() []int {
+	return t.order
+}
+
+// Does the digraph have a topological order
+func (t TopologicalX) HasOrder() bool {
+	return t.order != nil
+}
+
+// Does the digraph is a DAG
+// equivalently, does have a topological order
+func (t TopologicalX) IsDAG() bool {
+	return t.HasOrder()
+}
+
+// The rank of vertex v in the topological order
+// return -1, if the digraph is not a DAG
+func (t TopologicalX) Rank(v int) int {
+	t.validateVertex(v)
+	if t.HasOrder() {
+		return t.rank[v]
+	}
+	return -1
+}
+
+// Returns a directed cycle, as a path whose first and last vertices are the
+// same, if the digraph is not a DAG, or nil
+func (t TopologicalX) Cycle() []int {
+	return t.cycle
+}
+
+// The level of vertex v: the number of edges on a longest path to v
+// return -1, if the digraph is not a DAG
+func (t TopologicalX) Level(v int) int {
+	t.validateVertex(v)
+	if t.HasOrder() {
+		return t.level[v]
+	}
+	return -1
+}
+
+// Returns the vertices by level, each level in increasing order,
+// or nil if the digraph is not a DAG
+func (t TopologicalX) Levels() [][]int {
+	if !t.HasOrder() {
+		return nil
+	}
+	var levels [][]int
+	for v, l := range t.level {
+		for l >= len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[l] = append(levels[l], v)
+	}
+	return levels
+}
+
+func (t TopologicalX) validateVertex(v int) {
+	if v < 0 || v >= len(t.rank) {
+		panic("invalidate vertex")
+	}
+}
+
+// Returns the topological orders of digraph g, in lexicographic order, at
+// most limit of them, or all of them if limit <= 0: there may be as many as
+// V! orders. Returns nil if g is not a DAG.
+// Backtracking: an order is extended with each vertex of indegree 0 in
+// turn, and its edges are removed until the vertex is taken back.
+func AllTopologicalOrders(g IDigraph, limit int) [][]int {
+	// in a DAG, every partial order can be completed: no dead end
+	if !NewTopologicalX(g).HasOrder() {
+		return nil
+	}
+
+	n := g.V()
+	indegree := indegrees(g)
+	used := make([]bool, n)
+	// the vertex v is chosen (delta -1) or taken back (delta +1)
+	choose := func(v, delta int) {
+		used[v] = delta < 0
+		adj := graph.Adjacent(g, v)
+		for i := 0; i < adj.Len(); i++ {
+			indegree[adj.At(i)] += delta
+		}
+	}
+
+	var orders [][]int
+	order := make([]int, 0, n)
+	next := []int{0} // next[d]: the next vertex to try at position d of the order
+	for len(next) > 0 {
+		d := len(next) - 1
+		if d == n {
+			orders = append(orders, append([]int(nil), order...))
+			if len(orders) == limit {
+				break
+			}
+			next = next[:d]
+			continue
+		}
+
+		if len(order) > d {
+			choose(order[d], +1)
+			order = order[:d]
+		}
+		v := next[d]
+		for v < n && (used[v] || indegree[v] != 0) {
+			v++
+		}
+		if v == n {
+			next = next[:d]
+			continue
+		}
+
+		choose(v, -1)
+		order = append(order, v)
+		next[d] = v + 1
+		next = append(next, 0)
+	}
+
+	return orders
+}
@@ -0,0 +1,140 @@
+package digraph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestTopologicalX_DAG(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.DAG(8, i%20)
+		all := AllTopologicalOrders(g, 0)
+		if len(all) != countOrders(g) {
+			t.Fatalf("got %d orders, want %d", len(all), countOrders(g))
+		}
+		for j := 1; j < len(all); j++ {
+			if !less(all[j-1], all[j]) {
+				t.Fatalf("orders %v and %v not in lexicographic order", all[j-1], all[j])
+			}
+		}
+		if limited := AllTopologicalOrders(g, 3); !reflect.DeepEqual(limited, all[:min(3, len(all))]) {
+			t.Fatalf("limit 3: got %v", limited)
+		}
+
+		x, lex := NewTopologicalX(g), NewTopologicalXLex(g)
+		if !reflect.DeepEqual(lex.Order(), all[0]) {
+			t.Fatalf("lexicographic order: got %v, want %v", lex.Order(), all[0])
+		}
+		for _, order := range append(all, x.Order()) {
+			checkOrder(t, g, order)
+		}
+		if x.Cycle() != nil {
+			t.Fatalf("cycle in a DAG: %v", x.Cycle())
+		}
+
+		for v := 0; v < g.V(); v++ {
+			if x.Order()[x.Rank(v)] != v {
+				t.Fatalf("vertex %d: wrong rank %d", v, x.Rank(v))
+			}
+			// a vertex is one level after its last predecessor
+			last := -1
+			for u := 0; u < g.V(); u++ {
+				if g.HasEdge(u, v) && x.Level(u) > last {
+					last = x.Level(u)
+				}
+			}
+			if x.Level(v) != last+1 || lex.Level(v) != x.Level(v) {
+				t.Fatalf("vertex %d: level %d, want %d", v, x.Level(v), last+1)
+			}
+		}
+	}
+}
+
+func TestTopologicalX_cycle(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewDigraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.Simple(20, 10+i/2)
+		x := NewTopologicalX(g)
+		if x.IsDAG() != NewTopological(g).IsDAG() {
+			t.Fatalf("IsDAG: got %v", x.IsDAG())
+		}
+		if x.IsDAG() {
+			continue
+		}
+
+		cycle := x.Cycle()
+		if x.Order() != nil || x.Levels() != nil || x.Rank(0) != -1 || AllTopologicalOrders(g, 1) != nil {
+			t.Fatalf("order of a digraph with a cycle")
+		}
+		if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+			t.Fatalf("not a cycle: %v", cycle)
+		}
+		for j := 1; j < len(cycle); j++ {
+			if !g.HasEdge(cycle[j-1], cycle[j]) {
+				t.Fatalf("cycle %v: no edge %d->%d", cycle, cycle[j-1], cycle[j])
+			}
+		}
+	}
+}
+
+func checkOrder(t *testing.T, g *Digraph, order []int) {
+	t.Helper()
+	rank := make([]int, g.V())
+	for i, v := range order {
+		rank[v] = i
+	}
+	for v := 0; v < g.V(); v++ {
+		for _, w := range g.Adj(v) {
+			if rank[v] >= rank[w.(int)] {
+				t.Fatalf("order %v: edge %d->%d", order, v, w)
+			}
+		}
+	}
+}
+
+// counts the topological orders of a small DAG, over the sets of vertices
+// that can come first
+func countOrders(g *Digraph) int {
+	n := g.V()
+	pred := make([]int, n) // pred[v]: set of the vertices with an edge to v
+	for v := 0; v < n; v++ {
+		for _, w := range g.Adj(v) {
+			pred[w.(int)] |= 1 << uint(v)
+		}
+	}
+	count := make([]int, 1<<uint(n))
+	count[0] = 1
+	for set := range count {
+		for v := 0; v < n; v++ {
+			if set&(1<<uint(v)) == 0 && pred[v]&^set == 0 {
+				count[set|1<<uint(v)] += count[set]
+			}
+		}
+	}
+	return count[len(count)-1]
+}
+
+func less(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
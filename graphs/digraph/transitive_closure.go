@@ -0,0 +1,112 @@
+package digraph
+
+import (
+	"context"
+	"math/bits"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Transitive closure.
+// The transitive closure of a digraph G is another digraph with the same set
+// of vertices, but with an edge from v to w if and only if w is reachable
+// from v in G.
+// Implements as algs4 does: a directed DFS from every vertex, in O(V(V+E))
+// time. The reachable vertices of each vertex are kept as a bitset, so the
+// V² answers take V²/8 bytes, and queries take constant time. This is for
+// small digraphs: see ReachabilityIndex for large ones.
+
+type TransitiveClosure struct {
+	tc []bitset // tc[v]: vertices reachable from v
+}
+
+func NewTransitiveClosure(g IDigraph) TransitiveClosure {
+	tc, _ := NewTransitiveClosureContext(context.Background(), g, nil)
+	return tc
+}
+
+// Same as NewTransitiveClosure, but stops and returns ctx.Err() when ctx is
+// done, see graphs.Tracker. progress may be nil; each vertex is counted in
+// each search that reaches it.
+func NewTransitiveClosureContext(ctx context.Context, g IDigraph, progress graphs.Progress) (TransitiveClosure, error) {
+	n := g.V()
+	tc := TransitiveClosure{make([]bitset, n)}
+	t := graphs.NewTracker(ctx, progress)
+	for v := 0; v < n && t.OK(); v++ {
+		tc.tc[v] = newBitset(n)
+		tc.dfs(g, v, t)
+	}
+	if err := t.Done(); err != nil {
+		return TransitiveClosure{}, err
+	}
+	return tc, nil
+}
+
+// marks the vertices reachable from s in tc[s], as DirectedDFS does, until
+// t says to stop
+func (tc TransitiveClosure) dfs(g IDigraph, s int, t *graphs.Tracker) {
+	marked := tc.tc[s]
+	marked.set(s)
+	stack := []dfsFrame{{v: s, adj: graph.Adjacent(g, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			if !t.Visit() {
+				return
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
+		if !marked.has(w) {
+			marked.set(w)
+			stack = append(stack, dfsFrame{v: w, adj: graph.Adjacent(g, w)})
+		}
+	}
+}
+
+// Is there a directed path from vertex v to vertex w in the digraph?
+func (tc TransitiveClosure) Reachable(v, w int) bool {
+	tc.validateVertex(v)
+	tc.validateVertex(w)
+	return tc.tc[v].has(w)
+}
+
+// Returns the number of vertices reachable from vertex v, v included
+func (tc TransitiveClosure) Count(v int) int {
+	tc.validateVertex(v)
+	return tc.tc[v].count()
+}
+
+func (tc TransitiveClosure) validateVertex(v int) {
+	if v < 0 || v >= len(tc.tc) {
+		panic("invalidate vertex")
+	}
+}
+
+// bitset is a set of small non-negative integers, one bit each
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (b bitset) count() int {
+	n := 0
+	for _, word := range b {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
@@ -0,0 +1,113 @@
+package digraph
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// WordNet is a semantic lexicon of English: it groups words into sets of
+// synonyms called synsets, and a synset is a hypernym of another one if it
+// is more general ("animal" is a hypernym of "dog"). The hypernyms form a
+// rooted DAG, and the distance between two nouns is the length of the
+// shortest ancestral path between their synsets (a noun may be in several
+// synsets): see ShortestCommonAncestor.
+// Two files describe WordNet, in the format of the WordNet assignment:
+// 1. synsets: lines "id,synonyms,gloss", where the synonyms are separated
+//    by spaces, and the gloss is a definition;
+// 2. hypernyms: lines "id,hypernym ids...", separated by commas, the input
+//    of a SymbolDigraph where the vertex names are the synset ids.
+
+type WordNet struct {
+	sg      SymbolDigraph    // synset id -> vertex
+	synsets []string         // synsets[v]: synonyms of the synset of vertex v
+	nouns   map[string][]int // noun -> vertices of its synsets
+	sca     *ShortestCommonAncestor
+}
+
+// New a WordNet from the synsets and hypernyms files.
+// Panics if a file cannot be read, or if the hypernyms are not a rooted DAG.
+func NewWordNet(synsets, hypernyms string) WordNet {
+	hr, err := testutil.NewReader(hypernyms)
+	if err != nil {
+		panic(err)
+	}
+	defer hr.Close()
+	sg, err := ReadSymbolDigraph(hr, ",")
+	if err != nil {
+		panic(err)
+	}
+
+	wn := WordNet{
+		sg:      *sg,
+		synsets: make([]string, sg.Digraph().V()),
+		nouns:   make(map[string][]int),
+	}
+
+	sr, err := testutil.NewReader(synsets)
+	if err != nil {
+		panic(err)
+	}
+	defer sr.Close()
+	scanner := bufio.NewScanner(sr)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), ",", 3)
+		if len(fields) < 2 {
+			continue
+		}
+		v, ok := sg.Lookup(fields[0])
+		if !ok {
+			panic(fmt.Sprintf("synset %s is not in the hypernyms", fields[0]))
+		}
+		wn.synsets[v] = fields[1]
+		for _, noun := range strings.Fields(fields[1]) {
+			wn.nouns[noun] = append(wn.nouns[noun], v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		panic(err)
+	}
+
+	wn.sca = NewShortestCommonAncestor(sg.Digraph())
+	return wn
+}
+
+// Returns all WordNet nouns, in alphabetical order
+func (wn WordNet) Nouns() []string {
+	nouns := make([]string, 0, len(wn.nouns))
+	for noun := range wn.nouns {
+		nouns = append(nouns, noun)
+	}
+	sort.Strings(nouns)
+	return nouns
+}
+
+// Is the word a WordNet noun?
+func (wn WordNet) IsNoun(word string) bool {
+	_, ok := wn.nouns[word]
+	return ok
+}
+
+// Returns the distance between nounA and nounB: the length of a shortest
+// ancestral path between a synset of nounA and a synset of nounB
+func (wn WordNet) Distance(nounA, nounB string) int {
+	return wn.sca.LengthSubset(wn.vertices(nounA), wn.vertices(nounB))
+}
+
+// Returns the synonyms of a synset that is the common ancestor of nounA and
+// nounB in a shortest ancestral path
+func (wn WordNet) SAP(nounA, nounB string) string {
+	ancestor, _ := wn.sca.AncestorSubset(wn.vertices(nounA), wn.vertices(nounB))
+	return wn.synsets[ancestor]
+}
+
+func (wn WordNet) vertices(noun string) []int {
+	vertices, ok := wn.nouns[noun]
+	if !ok {
+		panic(fmt.Sprintf("%s is not a WordNet noun", noun))
+	}
+	return vertices
+}
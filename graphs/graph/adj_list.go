@@ -0,0 +1,43 @@
+package graph
+
+import "github.com/youngzhu/algs4-go/fund"
+
+// CompactAdj is implemented by graphs that store each adjacency list as a
+// slice of vertices, such as the CSR graphs of package csr. Algorithms scan
+// such lists in place, instead of the boxed items returned by Adj.
+type CompactAdj interface {
+	// Targets returns the vertices adjacent to v, in the order of Adj(v).
+	// The slice must not be modified.
+	Targets(v int) []int32
+}
+
+// AdjList is the adjacency list of a vertex, for algorithms that accept any
+// IGraph: the targets of a CompactAdj graph, or the items of Adj otherwise.
+type AdjList struct {
+	items   fund.Iterator
+	targets []int32
+}
+
+// Adjacent returns the adjacency list of vertex v in graph g
+func Adjacent(g IGraph, v int) AdjList {
+	if c, ok := g.(CompactAdj); ok {
+		return AdjList{targets: c.Targets(v)}
+	}
+	return AdjList{items: g.Adj(v)}
+}
+
+// Len returns the number of vertices in the list
+func (a AdjList) Len() int {
+	if a.targets != nil {
+		return len(a.targets)
+	}
+	return len(a.items)
+}
+
+// At returns the i-th vertex in the list
+func (a AdjList) At(i int) int {
+	if a.targets != nil {
+		return int(a.targets[i])
+	}
+	return a.items[i].(int)
+}
@@ -0,0 +1,183 @@
+package graph
+
+import "sort"
+
+// Biconnectivity.
+// An articulation vertex (or cut vertex) is a vertex whose removal increases
+// the number of connected components. A graph is biconnected if it has no
+// articulation vertices, and its biconnected components (or blocks) are its
+// maximal biconnected subgraphs: every edge belongs to exactly one block, and
+// two blocks share at most one vertex, which is an articulation vertex.
+//
+// Both are found with a single DFS computing low[v], the lowest preorder number
+// reachable from v by going down the DFS tree and then following at most one
+// back edge. A non-root vertex v is an articulation vertex if and only if it
+// has a child w with low[w] >= pre[v] (the subtree of w can not get around v),
+// the root if and only if it has more than one child. The edges are kept on a
+// stack while searching, so that the edges of the block below v-w are exactly
+// the ones above v-w when w is done.
+//
+// The DFS uses an explicit stack instead of recursion, so that graphs with a
+// long path do not need a deep goroutine stack.
+
+type Biconnected struct {
+	graph        Graph
+	pre          []int   // pre[v]: preorder number of v (-1 if not visited)
+	low          []int   // low[v]: lowest preorder number reachable from v
+	articulation []bool  // articulation[v]: is v an articulation vertex?
+	blocks       [][]int // blocks[i]: vertices of the i-th biconnected component
+	counter      int     // counter for preorder numbering
+}
+
+// dfsFrame is a vertex on the explicit DFS stack
+type dfsFrame struct {
+	v, parent int
+	adj       AdjList // adjacency list of v
+	next      int     // index in adj of the next vertex to look at
+	children  int     // number of tree edges from v
+	skipped   bool    // has the edge back to parent been skipped?
+}
+
+// Computes the articulation vertices and biconnected components of graph g.
+// Isolated vertices are blocks by themselves; self-loops are ignored.
+func NewBiconnected(g Graph) *Biconnected {
+	n := g.V()
+	b := &Biconnected{
+		graph:        g,
+		pre:          make([]int, n),
+		low:          make([]int, n),
+		articulation: make([]bool, n),
+	}
+	for v := 0; v < n; v++ {
+		b.pre[v] = -1
+	}
+
+	for v := 0; v < n; v++ {
+		if b.pre[v] == -1 {
+			b.dfs(v)
+		}
+	}
+
+	return b
+}
+
+func (b *Biconnected) dfs(root int) {
+	var edges [][2]int // edges of the blocks not finished yet
+
+	b.visit(root)
+	stack := []*dfsFrame{{v: root, parent: -1, adj: Adjacent(&b.graph, root)}}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		v := f.v
+
+		if f.next == f.adj.Len() {
+			// v is done, back to its parent
+			stack = stack[:len(stack)-1]
+			if f.parent == -1 {
+				if f.children > 1 {
+					b.articulation[v] = true
+				}
+				if f.children == 0 {
+					b.blocks = append(b.blocks, []int{v})
+				}
+				continue
+			}
+
+			u := f.parent
+			b.low[u] = min(b.low[u], b.low[v])
+			if b.low[v] >= b.pre[u] {
+				if u != root {
+					b.articulation[u] = true
+				}
+				edges = b.popBlock(edges, u, v)
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
+
+		switch {
+		case w == v:
+			// self-loop
+		case w == f.parent && !f.skipped:
+			// the tree edge we came by, a parallel edge is a back edge
+			f.skipped = true
+		case b.pre[w] == -1:
+			f.children++
+			edges = append(edges, [2]int{v, w})
+			b.visit(w)
+			stack = append(stack, &dfsFrame{v: w, parent: v, adj: Adjacent(&b.graph, w)})
+		case b.pre[w] < b.pre[v]:
+			// back edge
+			edges = append(edges, [2]int{v, w})
+			b.low[v] = min(b.low[v], b.pre[w])
+		}
+	}
+}
+
+func (b *Biconnected) visit(v int) {
+	b.pre[v] = b.counter
+	b.low[v] = b.counter
+	b.counter++
+}
+
+// pops the edges of the block below the tree edge u-v
+func (b *Biconnected) popBlock(edges [][2]int, u, v int) [][2]int {
+	seen := make(map[int]bool)
+	var block []int
+	for {
+		e := edges[len(edges)-1]
+		edges = edges[:len(edges)-1]
+		for _, x := range e {
+			if !seen[x] {
+				seen[x] = true
+				block = append(block, x)
+			}
+		}
+		if e == [2]int{u, v} {
+			break
+		}
+	}
+	sort.Ints(block)
+	b.blocks = append(b.blocks, block)
+	return edges
+}
+
+// Returns true if v is an articulation vertex
+func (b *Biconnected) IsArticulation(v int) bool {
+	b.graph.validateVertex(v)
+	return b.articulation[v]
+}
+
+// Returns the articulation vertices, in increasing order
+func (b *Biconnected) ArticulationPoints() []int {
+	var points []int
+	for v, ok := range b.articulation {
+		if ok {
+			points = append(points, v)
+		}
+	}
+	return points
+}
+
+// Returns the number of biconnected components
+func (b *Biconnected) Count() int {
+	return len(b.blocks)
+}
+
+// Returns the vertices of the i-th biconnected component, in increasing order
+func (b *Biconnected) Block(i int) []int {
+	if i < 0 || i >= len(b.blocks) {
+		panic("invalid block")
+	}
+	return b.blocks[i]
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
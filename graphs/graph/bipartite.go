@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/fund"
+)
+
+// Two-colorability.
+// Can the vertices of a given graph be assigned one of two colors in such a
+// way that no edge connects vertices of the same color? This is equivalent
+// to: is the graph bipartite? Another client of DFS: color each vertex the
+// opposite of the vertex it was reached from. If we ever find an edge v-w
+// with both ends of the same color, the tree path from w to v plus the edge
+// v-w is a cycle of odd length, and no two-coloring exists.
+
+type Bipartite struct {
+	graph       Graph
+	isBipartite bool   // is the graph bipartite?
+	color       []bool // color[v]: gives vertices on one side of bipartition
+	marked      []bool // marked[v]: has vertex v been visited in DFS?
+	edgeTo      []int  // edgeTo[v]: last edge on path to v
+	cycle       []int  // odd-length cycle (or nil if bipartite)
+}
+
+// Determines whether the undirected graph g is bipartite and finds either
+// a bipartition or an odd-length cycle
+func NewBipartite(g Graph) *Bipartite {
+	b := &Bipartite{
+		graph:       g,
+		isBipartite: true,
+		color:       make([]bool, g.V()),
+		marked:      make([]bool, g.V()),
+		edgeTo:      make([]int, g.V()),
+	}
+
+	for v := 0; v < g.V(); v++ {
+		if !b.marked[v] {
+			b.dfs(v)
+		}
+	}
+
+	return b
+}
+
+func (b *Bipartite) dfs(v int) {
+	b.marked[v] = true
+
+	for _, ww := range b.graph.Adj(v) {
+		w := ww.(int)
+
+		// short circuit if odd-length cycle found
+		if b.cycle != nil {
+			return
+		}
+
+		if !b.marked[w] { // found uncolored vertex, so recur
+			b.edgeTo[w] = v
+			b.color[w] = !b.color[v]
+			b.dfs(w)
+		} else if b.color[w] == b.color[v] { // if v-w create an odd-length cycle, find it
+			b.isBipartite = false
+
+			stack := fund.NewStack()
+			stack.Push(w) // don't need this unless you want to include start vertex twice
+			for x := v; x != w; x = b.edgeTo[x] {
+				stack.Push(x)
+			}
+			stack.Push(w)
+
+			for !stack.IsEmpty() {
+				b.cycle = append(b.cycle, stack.Pop().(int))
+			}
+		}
+	}
+}
+
+// Returns true if the graph is bipartite
+func (b *Bipartite) IsBipartite() bool {
+	return b.isBipartite
+}
+
+// Returns the side of the bipartite that vertex v is on.
+// Two vertices are in the same side of the bipartition if and only if
+// they have the same color.
+// Panics if the graph is not bipartite.
+func (b *Bipartite) Color(v int) bool {
+	b.graph.validateVertex(v)
+	if !b.isBipartite {
+		panic("graph is not bipartite")
+	}
+	return b.color[v]
+}
+
+// Returns an odd-length cycle as a sequence of vertices whose first and last
+// vertex are the same, or nil if the graph is bipartite
+func (b *Bipartite) OddCycle() []int {
+	return b.cycle
+}
+
+// Check verifies the result: every edge connects vertices of different
+// colors, or the odd cycle is a simple cycle of the graph with an odd number
+// of edges. Returns nil if everything checks out.
+func (b *Bipartite) Check() error {
+	g := b.graph
+
+	if b.isBipartite {
+		for v := 0; v < g.V(); v++ {
+			for _, w := range g.Adj(v) {
+				if b.color[v] == b.color[w.(int)] {
+					return fmt.Errorf("edge %d-%d with %d and %d in same side of bipartition", v, w, v, w)
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := checkCycle(g, b.cycle); err != nil {
+		return err
+	}
+	if (len(b.cycle)-1)%2 == 0 {
+		return fmt.Errorf("cycle has even length %d", len(b.cycle)-1)
+	}
+	return nil
+}
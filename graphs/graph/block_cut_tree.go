@@ -0,0 +1,91 @@
+package graph
+
+// Block-cut tree.
+// The biconnected components of a connected graph are held together by its
+// articulation vertices in a tree: the block-cut tree has a node for each
+// block and a node for each articulation vertex, and an edge between a block
+// and each articulation vertex it contains. Paths between two vertices of
+// the graph go through exactly the blocks and articulation vertices on the
+// path between them in the tree. A graph that is not connected gives a forest.
+//
+// Nodes 0 to Blocks()-1 are the blocks, in the order found by Biconnected,
+// the following ones are the articulation vertices, in increasing order.
+
+type BlockCutTree struct {
+	graph  Graph
+	tree   *Graph  // the block-cut tree (or forest)
+	blocks [][]int // blocks[x]: vertices of block node x
+	cuts   []int   // cuts[x-len(blocks)]: articulation vertex of cut node x
+	node   []int   // node[v]: cut node of v if it is an articulation vertex, else its block
+}
+
+// Computes the block-cut tree of graph g
+func NewBlockCutTree(g Graph) *BlockCutTree {
+	bc := NewBiconnected(g)
+
+	t := &BlockCutTree{
+		graph: g,
+		cuts:  bc.ArticulationPoints(),
+		node:  make([]int, g.V()),
+	}
+	for i := 0; i < bc.Count(); i++ {
+		t.blocks = append(t.blocks, bc.Block(i))
+	}
+
+	for i, v := range t.cuts {
+		t.node[v] = len(t.blocks) + i
+	}
+
+	t.tree = NewGraphN(len(t.blocks) + len(t.cuts))
+	for x, block := range t.blocks {
+		for _, v := range block {
+			if bc.IsArticulation(v) {
+				t.tree.AddEdge(x, t.node[v])
+			} else {
+				t.node[v] = x
+			}
+		}
+	}
+
+	return t
+}
+
+// Returns the block-cut tree
+func (t *BlockCutTree) Tree() *Graph {
+	return t.tree
+}
+
+// Returns the number of block nodes
+func (t *BlockCutTree) Blocks() int {
+	return len(t.blocks)
+}
+
+// Returns true if node x of the tree is a block,
+// false if it is an articulation vertex
+func (t *BlockCutTree) IsBlock(x int) bool {
+	t.tree.validateVertex(x)
+	return x < len(t.blocks)
+}
+
+// Returns the vertices of the graph in block node x
+func (t *BlockCutTree) Block(x int) []int {
+	if !t.IsBlock(x) {
+		panic("not a block node")
+	}
+	return t.blocks[x]
+}
+
+// Returns the articulation vertex of cut node x
+func (t *BlockCutTree) CutVertex(x int) int {
+	if t.IsBlock(x) {
+		panic("not a cut node")
+	}
+	return t.cuts[x-len(t.blocks)]
+}
+
+// Returns the node of the tree for vertex v: its cut node if v is an
+// articulation vertex, otherwise the only block containing v
+func (t *BlockCutTree) Node(v int) int {
+	t.graph.validateVertex(v)
+	return t.node[v]
+}
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/fund"
 	"github.com/youngzhu/algs4-go/graphs"
 )
@@ -18,19 +20,57 @@ import (
 // on the queue, then perform the following steps until the queue is empty:
 // 1. Remove the next vertex v from the queue
 // 2. Put onto the queue all unmarked vertices that are adjacent to v and mark them
+// With several sources, all of them are put on the queue at the beginning:
+// the search finds the shortest paths from the nearest source.
 
 type BreadthFirstPaths struct {
-	graph  Graph
-	source int    // source vertex
-	marked []bool // marked[v]: is there an s-v path?
-	edgeTo []int  // edgeTo[v]: previous edge on shortest s-v path
-	distTo []int  // distTo[v]: number of edges on shortest s-v path
+	graph   IGraph
+	sources []int  // source vertices
+	marked  []bool // marked[v]: is there an s-v path?
+	edgeTo  []int  // edgeTo[v]: previous edge on shortest s-v path
+	distTo  []int  // distTo[v]: number of edges on shortest s-v path
 }
 
 // Computes the shortest path between the source vertex (s)
 // and every other vertex in graph g
 func NewBreadthFirstPaths(g Graph, s int) BreadthFirstPaths {
-	g.validateVertex(s)
+	return NewBreadthFirstPathsOf(&g, s)
+}
+
+// Same as NewBreadthFirstPaths, for any IGraph, such as a CSR graph
+func NewBreadthFirstPathsOf(g IGraph, s int) BreadthFirstPaths {
+	p, _ := NewBreadthFirstPathsContext(context.Background(), g, s, nil)
+	return p
+}
+
+// Same as NewBreadthFirstPaths, but stops and returns ctx.Err() when ctx is
+// done, see graphs.Tracker. progress may be nil.
+func NewBreadthFirstPathsContext(ctx context.Context, g IGraph, s int, progress graphs.Progress) (BreadthFirstPaths, error) {
+	marked := make([]bool, g.V())
+	edgeTo := make([]int, g.V())
+	distTo := make([]int, g.V())
+
+	for v := 0; v < g.V(); v++ {
+		distTo[v] = graphs.InfinityDistance
+	}
+
+	path := BreadthFirstPaths{g, []int{s}, marked, edgeTo, distTo}
+	path.validateVertex(s)
+	t := graphs.NewTracker(ctx, progress)
+	path.bfs(t)
+	if err := t.Done(); err != nil {
+		return BreadthFirstPaths{}, err
+	}
+
+	return path, nil
+}
+
+// Computes the shortest path between any one of the source vertices and
+// every other vertex in graph g
+func NewBreadthFirstPathsMulti(g IGraph, sources []int) BreadthFirstPaths {
+	if len(sources) == 0 {
+		panic("zero vertices")
+	}
 
 	marked := make([]bool, g.V())
 	edgeTo := make([]int, g.V())
@@ -40,25 +80,33 @@ func NewBreadthFirstPaths(g Graph, s int) BreadthFirstPaths {
 		distTo[v] = graphs.InfinityDistance
 	}
 
-	path := BreadthFirstPaths{g, s, marked, edgeTo, distTo}
-	path.bfs()
+	path := BreadthFirstPaths{g, sources, marked, edgeTo, distTo}
+	for _, s := range sources {
+		path.validateVertex(s)
+	}
+	path.bfs(graphs.NewTracker(context.Background(), nil))
 
 	return path
 }
 
-// breadth first search from s
-func (p BreadthFirstPaths) bfs() {
+// breadth first search from the sources, until t says to stop
+func (p BreadthFirstPaths) bfs(t *graphs.Tracker) {
 	queue := fund.NewQueue()
 
-	p.marked[p.source] = true
-	p.distTo[p.source] = 0
-	queue.Enqueue(fund.Item(p.source))
+	for _, s := range p.sources {
+		if !p.marked[s] {
+			p.marked[s] = true
+			p.distTo[s] = 0
+			queue.Enqueue(fund.Item(s))
+		}
+	}
 
-	for !queue.IsEmpty() {
+	for !queue.IsEmpty() && t.Visit() {
 		v := queue.Dequeue().(int)
 
-		for _, ww := range p.graph.Adj(v) {
-			w := ww.(int)
+		adj := Adjacent(p.graph, v)
+		for i := 0; i < adj.Len(); i++ {
+			w := adj.At(i)
 			if !p.marked[w] {
 				p.marked[w] = true
 				p.edgeTo[w] = v
@@ -71,19 +119,19 @@ func (p BreadthFirstPaths) bfs() {
 
 // Is there a path between the source vertex (s) and vertex (v)
 func (p BreadthFirstPaths) HasPathTo(v int) bool {
-	p.graph.validateVertex(v)
+	p.validateVertex(v)
 	return p.marked[v]
 }
 
 // Returns the number of edges in a shortest path between the source vertex s
-// and vertex v
+// (or the nearest source) and vertex v
 func (p BreadthFirstPaths) DistTo(v int) int {
-	p.graph.validateVertex(v)
+	p.validateVertex(v)
 	return p.distTo[v]
 }
 
-// Returns a path between the source vertex (s) and vertex v
-// or nil if no such path
+// Returns a shortest path between the source vertex (s) (or the nearest
+// source) and vertex v, or nil if no such path
 func (p BreadthFirstPaths) PathTo(v int) []int {
 	if !p.HasPathTo(v) {
 		return nil
@@ -91,10 +139,11 @@ func (p BreadthFirstPaths) PathTo(v int) []int {
 
 	stack := fund.NewStack()
 
-	for x := v; x != p.source; x = p.edgeTo[x] {
+	x := v
+	for ; p.distTo[x] != 0; x = p.edgeTo[x] {
 		stack.Push(fund.Item(x))
 	}
-	stack.Push(fund.Item(p.source))
+	stack.Push(fund.Item(x))
 
 	path := make([]int, stack.Size())
 	i := 0
@@ -105,3 +154,9 @@ func (p BreadthFirstPaths) PathTo(v int) []int {
 
 	return path
 }
+
+func (p BreadthFirstPaths) validateVertex(v int) {
+	if v < 0 || v >= len(p.marked) {
+		panic("invalidate vertex")
+	}
+}
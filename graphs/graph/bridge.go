@@ -0,0 +1,136 @@
+package graph
+
+// Bridges.
+// A bridge (or cut edge) is an edge whose removal increases the number of
+// connected components. An edge is a bridge if and only if it is not on any
+// cycle. Removing all bridges leaves the 2-edge-connected components: the
+// maximal subgraphs in which any two vertices are connected by two paths
+// without an edge in common.
+//
+// The same low-link DFS as Biconnected: a tree edge v-w is a bridge if and
+// only if low[w] > pre[v], i.e. nothing below w reaches v or above. Parallel
+// edges are never bridges, since only one copy of the edge back to the parent
+// is skipped. Vertices are kept on a stack as they are visited; when w is done
+// and low[w] == pre[w], w and the vertices above it on the stack make up a
+// 2-edge-connected component.
+
+type Bridge struct {
+	graph   Graph
+	pre     []int    // pre[v]: preorder number of v (-1 if not visited)
+	low     []int    // low[v]: lowest preorder number reachable from v
+	id      []int    // id[v]: 2-edge-connected component containing v
+	bridges [][2]int // the bridges, as parent-child pairs of the DFS tree
+	count   int      // number of 2-edge-connected components
+	counter int      // counter for preorder numbering
+}
+
+// Computes the bridges and 2-edge-connected components of graph g
+func NewBridge(g Graph) *Bridge {
+	n := g.V()
+	b := &Bridge{
+		graph: g,
+		pre:   make([]int, n),
+		low:   make([]int, n),
+		id:    make([]int, n),
+	}
+	for v := 0; v < n; v++ {
+		b.pre[v] = -1
+	}
+
+	for v := 0; v < n; v++ {
+		if b.pre[v] == -1 {
+			b.dfs(v)
+		}
+	}
+
+	return b
+}
+
+func (b *Bridge) dfs(root int) {
+	var vertices []int // vertices of the components not finished yet
+
+	b.visit(root)
+	vertices = append(vertices, root)
+	stack := []*dfsFrame{{v: root, parent: -1, adj: Adjacent(&b.graph, root)}}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		v := f.v
+
+		if f.next == f.adj.Len() {
+			// v is done, back to its parent
+			stack = stack[:len(stack)-1]
+			if b.low[v] == b.pre[v] {
+				for {
+					x := vertices[len(vertices)-1]
+					vertices = vertices[:len(vertices)-1]
+					b.id[x] = b.count
+					if x == v {
+						break
+					}
+				}
+				b.count++
+				if f.parent != -1 {
+					b.bridges = append(b.bridges, [2]int{f.parent, v})
+				}
+			}
+			if f.parent != -1 {
+				b.low[f.parent] = min(b.low[f.parent], b.low[v])
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
+
+		switch {
+		case w == v:
+			// self-loop
+		case w == f.parent && !f.skipped:
+			// the tree edge we came by, a parallel edge is a back edge
+			f.skipped = true
+		case b.pre[w] == -1:
+			b.visit(w)
+			vertices = append(vertices, w)
+			stack = append(stack, &dfsFrame{v: w, parent: v, adj: Adjacent(&b.graph, w)})
+		default:
+			b.low[v] = min(b.low[v], b.pre[w])
+		}
+	}
+}
+
+func (b *Bridge) visit(v int) {
+	b.pre[v] = b.counter
+	b.low[v] = b.counter
+	b.counter++
+}
+
+// Returns the bridges, each one as a pair of vertices
+func (b *Bridge) Bridges() [][2]int {
+	return b.bridges
+}
+
+// Returns true if v-w is a bridge
+func (b *Bridge) IsBridge(v, w int) bool {
+	b.graph.validateVertex(v)
+	b.graph.validateVertex(w)
+	return b.id[v] != b.id[w] && b.graph.HasEdge(v, w)
+}
+
+// Returns the number of 2-edge-connected components
+func (b *Bridge) Count() int {
+	return b.count
+}
+
+// Returns the 2-edge-connected component id of vertex v
+func (b *Bridge) Id(v int) int {
+	b.graph.validateVertex(v)
+	return b.id[v]
+}
+
+// Returns true if v and w are in the same 2-edge-connected component
+func (b *Bridge) Connected(v, w int) bool {
+	b.graph.validateVertex(v)
+	b.graph.validateVertex(w)
+	return b.id[v] == b.id[w]
+}
@@ -1,8 +1,15 @@
 package graph
 
+import (
+	"context"
+
+	"github.com/youngzhu/algs4-go/graphs"
+)
+
 // Another client of DFS, find the connected components of a graph.
+// The search uses an explicit stack, see DepthFirstSearch.
 type ConnectedComponents struct {
-	graph  Graph
+	graph  IGraph
 	marked []bool // makred[v]: has vertex v been marked?
 	id     []int  // id[v]: id of connected component containing v
 	size   []int  // size[id]: number of vertices in given component
@@ -10,44 +17,78 @@ type ConnectedComponents struct {
 }
 
 func NewConnectedComponents(g Graph) ConnectedComponents {
+	return NewConnectedComponentsOf(&g)
+}
+
+// Same as NewConnectedComponents, for any IGraph, such as a CSR graph
+func NewConnectedComponentsOf(g IGraph) ConnectedComponents {
+	cc, _ := NewConnectedComponentsContext(context.Background(), g, nil)
+	return cc
+}
+
+// Same as NewConnectedComponents, but stops and returns ctx.Err() when ctx is
+// done, see graphs.Tracker. progress may be nil.
+func NewConnectedComponentsContext(ctx context.Context, g IGraph, progress graphs.Progress) (ConnectedComponents, error) {
 	marked := make([]bool, g.V())
 	id := make([]int, g.V())
 	size := make([]int, g.V())
 
 	cc := ConnectedComponents{g, marked, id, size, 0}
+	t := graphs.NewTracker(ctx, progress)
 
-	for v := 0; v < g.V(); v++ {
+	for v := 0; v < g.V() && t.OK(); v++ {
 		if !cc.marked[v] {
-			cc.dfs(v)
+			cc.dfs(v, t)
 			cc.count++
 		}
 	}
+	if err := t.Done(); err != nil {
+		return ConnectedComponents{}, err
+	}
 
-	return cc
+	return cc, nil
 }
 
-func (cc ConnectedComponents) dfs(v int) {
-	cc.marked[v] = true
-	cc.id[v] = cc.count
-	cc.size[cc.count]++
-	for _, ww := range cc.graph.Adj(v) {
-		w := ww.(int)
+// the search from s, until t says to stop
+func (cc ConnectedComponents) dfs(s int, t *graphs.Tracker) {
+	cc.visit(s)
+	stack := []dfsFrame{{v: s, adj: Adjacent(cc.graph, s)}}
+
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			if !t.Visit() {
+				return
+			}
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
 		if !cc.marked[w] {
-			cc.dfs(w)
+			cc.visit(w)
+			stack = append(stack, dfsFrame{v: w, adj: Adjacent(cc.graph, w)})
 		}
 	}
 }
 
+func (cc ConnectedComponents) visit(v int) {
+	cc.marked[v] = true
+	cc.id[v] = cc.count
+	cc.size[cc.count]++
+}
+
 // Returns the component id of the connected component containing vertex v
 func (cc ConnectedComponents) Id(v int) int {
-	cc.graph.validateVertex(v)
+	cc.validateVertex(v)
 	return cc.id[v]
 }
 
 // Returns the number of vertices in the connected compoent containing vertex v
 func (cc ConnectedComponents) Size(v int) int {
-	cc.graph.validateVertex(v)
-	return cc.size[v]
+	cc.validateVertex(v)
+	return cc.size[cc.id[v]]
 }
 
 // Returns the number of connected components in the graph
@@ -57,7 +98,13 @@ func (cc ConnectedComponents) Count() int {
 
 // Returns true if vertices v and w are in the same connected component
 func (cc ConnectedComponents) Connected(v, w int) bool {
-	cc.graph.validateVertex(v)
-	cc.graph.validateVertex(w)
+	cc.validateVertex(v)
+	cc.validateVertex(w)
 	return cc.id[v] == cc.id[w]
 }
+
+func (cc ConnectedComponents) validateVertex(v int) {
+	if v < 0 || v >= len(cc.id) {
+		panic("invalidate vertex")
+	}
+}
@@ -0,0 +1,21 @@
+package graph
+
+import "testing"
+
+// Size(v) is the size of the component of v, whose id is not v
+func TestConnectedComponents_size(t *testing.T) {
+	g := NewGraphN(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(1, 4)
+
+	cc := NewConnectedComponents(*g)
+	if cc.Count() != 3 {
+		t.Fatalf("components: got %d, want 3", cc.Count())
+	}
+	for v, want := range []int{3, 2, 1, 3, 2, 3} {
+		if cc.Size(v) != want {
+			t.Errorf("size(%d): got %d, want %d", v, cc.Size(v), want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestContext(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	g := NewGraphGenerator(r).Simple(5000, 10000)
+
+	// the same results, and progress up to the vertices processed
+	last := 0
+	progress := func(n int) { last = n }
+	bfs, err := NewBreadthFirstPathsContext(context.Background(), g, 0, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewBreadthFirstPaths(*g, 0)
+	reached := 0
+	for v := 0; v < g.V(); v++ {
+		if bfs.DistTo(v) != want.DistTo(v) {
+			t.Fatalf("distance to %d: %d, want %d", v, bfs.DistTo(v), want.DistTo(v))
+		}
+		if want.HasPathTo(v) {
+			reached++
+		}
+	}
+	if last != reached {
+		t.Errorf("BFS: progress %d, want %d", last, reached)
+	}
+
+	cc, err := NewConnectedComponentsContext(context.Background(), g, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cc.Count() != NewConnectedComponents(*g).Count() || last != g.V() {
+		t.Errorf("CC: %d components, progress %d", cc.Count(), last)
+	}
+
+	// canceled at the first report
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := func(n int) {
+		last = n
+		cancel()
+	}
+	if _, err := NewBreadthFirstPathsContext(ctx, g, 0, stop); err != context.Canceled || last != graphs.CheckInterval {
+		t.Errorf("BFS: %v after %d vertices", err, last)
+	}
+	if _, err := NewConnectedComponentsContext(ctx, g, nil); err != context.Canceled {
+		t.Errorf("CC: %v", err)
+	}
+}
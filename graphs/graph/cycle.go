@@ -0,0 +1,187 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/fund"
+)
+
+// Cycle detection.
+// Is a given graph acyclic? Another client of DFS: while searching, if we
+// reach a marked vertex w through an edge v-w that is not the edge we came to
+// v by, then v-w closes a cycle, which we read off edgeTo[] back from v to w.
+// Self-loops and parallel edges are checked first, since DFS would not see
+// them as an edge different from the one it came by.
+
+type Cycle struct {
+	graph  Graph
+	marked []bool // marked[v]: has vertex v been marked?
+	edgeTo []int  // edgeTo[v]: previous vertex on path to v
+	cycle  []int  // the cycle (or nil if no such cycle)
+}
+
+// Determines whether the undirected graph g has a cycle and,
+// if so, finds such a cycle
+func NewCycle(g Graph) *Cycle {
+	c := &Cycle{graph: g}
+
+	if c.hasSelfLoop() || c.hasParallelEdges() {
+		return c
+	}
+
+	c.marked = make([]bool, g.V())
+	c.edgeTo = make([]int, g.V())
+	for v := 0; v < g.V(); v++ {
+		if !c.marked[v] {
+			c.dfs(-1, v)
+		}
+	}
+
+	return c
+}
+
+// does this graph have a self loop?
+// side effect: initialize cycle to be self loop
+func (c *Cycle) hasSelfLoop() bool {
+	for v := 0; v < c.graph.V(); v++ {
+		for _, w := range c.graph.Adj(v) {
+			if w.(int) == v {
+				c.cycle = []int{v, v}
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// does this graph have two parallel edges?
+// side effect: initialize cycle to be two parallel edges
+func (c *Cycle) hasParallelEdges() bool {
+	marked := make([]bool, c.graph.V())
+
+	for v := 0; v < c.graph.V(); v++ {
+		// check for parallel edges incident to v
+		for _, ww := range c.graph.Adj(v) {
+			w := ww.(int)
+			if marked[w] {
+				c.cycle = []int{v, w, v}
+				return true
+			}
+			marked[w] = true
+		}
+
+		// reset so marked[w] = false for all w
+		for _, w := range c.graph.Adj(v) {
+			marked[w.(int)] = false
+		}
+	}
+	return false
+}
+
+// depth first search from v, having come from u
+func (c *Cycle) dfs(u, v int) {
+	c.marked[v] = true
+
+	for _, ww := range c.graph.Adj(v) {
+		w := ww.(int)
+
+		// short circuit if cycle already found
+		if c.cycle != nil {
+			return
+		}
+
+		if !c.marked[w] {
+			c.edgeTo[w] = v
+			c.dfs(v, w)
+		} else if w != u { // check for cycle (but disregard reverse of edge leading to v)
+			stack := fund.NewStack()
+			for x := v; x != w; x = c.edgeTo[x] {
+				stack.Push(x)
+			}
+			stack.Push(w)
+			stack.Push(v)
+
+			for !stack.IsEmpty() {
+				c.cycle = append(c.cycle, stack.Pop().(int))
+			}
+		}
+	}
+}
+
+// Returns true if the graph has a cycle
+func (c *Cycle) HasCycle() bool {
+	return c.cycle != nil
+}
+
+// Returns a cycle as a sequence of vertices whose first and last vertex
+// are the same, or nil if the graph is acyclic
+func (c *Cycle) Cycle() []int {
+	return c.cycle
+}
+
+// Check verifies the result: the cycle uses edges of the graph and does not
+// repeat a vertex, or, if there is no cycle, the graph is a forest.
+// Returns nil if everything checks out.
+func (c *Cycle) Check() error {
+	g := c.graph
+
+	if !c.HasCycle() {
+		// a forest has exactly V - (number of trees) edges
+		cc := NewConnectedComponents(g)
+		if g.E() != g.V()-cc.Count() {
+			return fmt.Errorf("no cycle found, but %d edges in a graph with %d vertices and %d components",
+				g.E(), g.V(), cc.Count())
+		}
+		return nil
+	}
+
+	return checkCycle(g, c.cycle)
+}
+
+// verifies that cycle is a simple cycle in g
+func checkCycle(g Graph, cycle []int) error {
+	if len(cycle) < 2 {
+		return errors.New("cycle too short")
+	}
+	first, last := cycle[0], cycle[len(cycle)-1]
+	if first != last {
+		return fmt.Errorf("cycle begins with %d and ends with %d", first, last)
+	}
+
+	// the edges used, each one as many times as it appears in the graph
+	used := make(map[[2]int]int)
+	seen := make(map[int]bool)
+	for i := 1; i < len(cycle); i++ {
+		v, w := cycle[i-1], cycle[i]
+		if seen[v] {
+			return fmt.Errorf("vertex %d appears twice in cycle", v)
+		}
+		seen[v] = true
+
+		e := [2]int{v, w}
+		if v > w {
+			e = [2]int{w, v}
+		}
+		used[e]++
+		if used[e] > multiplicity(g, v, w) {
+			return fmt.Errorf("cycle uses edge %d-%d more often than it is in the graph", v, w)
+		}
+	}
+
+	return nil
+}
+
+// the number of parallel edges v-w in g
+func multiplicity(g Graph, v, w int) int {
+	count := 0
+	for _, x := range g.Adj(v) {
+		if x.(int) == w {
+			count++
+		}
+	}
+	if v == w {
+		count /= 2 // self loop appears in adjacency list twice
+	}
+	return count
+}
@@ -6,6 +6,7 @@ import "github.com/youngzhu/algs4-go/fund"
 // by setting edge[w] to v. In other words, v-w is the last edge on the known
 // path from s to w. The result of the search is a tree rooted at the source;
 // edgeTo[] is a parent-link representation of that tree.
+// The search uses an explicit stack, see DepthFirstSearch.
 
 type DepthFirstPaths struct {
 	graph  Graph
@@ -27,15 +28,24 @@ func NewDepthFirstPaths(g Graph, s int) DepthFirstPaths {
 	return path
 }
 
-// depth first search from v
-func (p DepthFirstPaths) dfs(g Graph, v int) {
-	p.marked[v] = true
+// depth first search from s
+func (p DepthFirstPaths) dfs(g Graph, s int) {
+	p.marked[s] = true
+	stack := []dfsFrame{{v: s, adj: Adjacent(&g, s)}}
 
-	for _, ww := range g.Adj(v) {
-		w := ww.(int)
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		v, w := f.v, f.adj.At(f.next)
+		f.next++
 		if !p.marked[w] {
+			p.marked[w] = true
 			p.edgeTo[w] = v
-			p.dfs(g, w)
+			stack = append(stack, dfsFrame{v: w, adj: Adjacent(&g, w)})
 		}
 	}
 }
@@ -6,6 +6,9 @@ package graph
 // - Mark it as having been visited
 // - Visit (recursively) all the vertices that are adjacent to it and that have
 // 	 not yet been marked
+// The recursion is replaced by an explicit stack of the vertices whose
+// adjacency lists are being scanned, so that a long path does not need a
+// deep goroutine stack. The vertices are visited in the same order.
 
 type DepthFirstSearch struct {
 	marked []bool // marked[v]: is there an s-v path?
@@ -18,21 +21,31 @@ func NewDepthFirstSearch(g Graph, s int) DepthFirstSearch {
 	g.validateVertex(s)
 	marked := make([]bool, g.V())
 
-	search := DepthFirstSearch{marked, 0}
+	search := &DepthFirstSearch{marked, 0}
 	search.dfs(g, s)
 
-	return search
+	return *search
 }
 
 // depth first search from s
-func (d DepthFirstSearch) dfs(g Graph, s int) {
+func (d *DepthFirstSearch) dfs(g Graph, s int) {
 	d.count++
 	d.marked[s] = true
+	stack := []dfsFrame{{v: s, adj: Adjacent(&g, s)}}
 
-	for _, ww := range g.Adj(s) {
-		w := ww.(int)
+	for len(stack) > 0 {
+		f := &stack[len(stack)-1]
+		if f.next == f.adj.Len() {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		w := f.adj.At(f.next)
+		f.next++
 		if !d.marked[w] {
-			d.dfs(g, w)
+			d.count++
+			d.marked[w] = true
+			stack = append(stack, dfsFrame{v: w, adj: Adjacent(&g, w)})
 		}
 	}
 }
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"reflect"
+	"runtime/debug"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// a path 0-1-2-...-(n-1) is as deep as a DFS can go
+func TestDepthFirstSearch_longPath(t *testing.T) {
+	const n = 1000000
+	g := NewGraphN(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(v-1, v)
+	}
+
+	// a recursive search would need far more than this
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	if dfs := NewDepthFirstSearch(*g, 0); dfs.Count() != n {
+		t.Errorf("count: got %d, want %d", dfs.Count(), n)
+	}
+	if path := NewDepthFirstPaths(*g, 0).PathTo(n - 1); len(path) != n {
+		t.Errorf("path length: got %d, want %d", len(path), n)
+	}
+	if cc := NewConnectedComponents(*g); cc.Count() != 1 {
+		t.Errorf("components: got %d, want 1", cc.Count())
+	}
+}
+
+// the count is the number of vertices connected to the source, in each
+// component
+func TestDepthFirstSearch_count(t *testing.T) {
+	g := NewGraphN(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(1, 4)
+
+	for s, want := range []int{3, 2, 1, 3, 2, 3} {
+		if dfs := NewDepthFirstSearch(*g, s); dfs.Count() != want {
+			t.Errorf("count from %d: got %d, want %d", s, dfs.Count(), want)
+		}
+	}
+}
+
+// the explicit stack visits the vertices in the same order as recursion
+func TestDepthFirstPaths_recursive(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewGraphGenerator(r)
+
+	for i := 0; i < 100; i++ {
+		g := gen.EulerianPath(50, 80)
+		s := r.UniformIntN(g.V())
+		p := NewDepthFirstPaths(*g, s)
+
+		marked := make([]bool, g.V())
+		edgeTo := make([]int, g.V())
+		var dfs func(v int)
+		dfs = func(v int) {
+			marked[v] = true
+			for _, ww := range g.Adj(v) {
+				w := ww.(int)
+				if !marked[w] {
+					edgeTo[w] = v
+					dfs(w)
+				}
+			}
+		}
+		dfs(s)
+
+		if !reflect.DeepEqual(p.marked, marked) || !reflect.DeepEqual(p.edgeTo, edgeTo) {
+			t.Fatalf("%v\nedgeTo: got %v, want %v", g, p.edgeTo, edgeTo)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Eulerian cycles and paths.
+// An Eulerian cycle is a cycle (not necessarily simple) that uses every edge
+// in the graph exactly once; an Eulerian path is a path that does so. A graph
+// has an Eulerian cycle if and only if every vertex has even degree and all
+// the edges are in the same connected component, and an Eulerian path if and
+// only if, in addition, at most two vertices have odd degree (the ends).
+//
+// Both are found with Hierholzer's algorithm: walk along unused edges until
+// stuck, which can only happen back at the start, then back up along the walk
+// to a vertex with an unused edge and splice in a closed walk from there.
+// The walk is kept on an explicit stack. Each edge is an object shared by the
+// adjacency lists of its two ends, so that parallel edges and self-loops are
+// used exactly once each.
+
+var (
+	ErrNoEdges           = errors.New("graph has no edges")
+	ErrDegreeImbalance   = errors.New("degree imbalance")
+	ErrDisconnectedEdges = errors.New("edges are not all connected")
+)
+
+// Finds an Eulerian cycle in an undirected graph, if one exists
+type EulerianCycle struct {
+	cycle []int // Eulerian cycle (or nil if no such cycle)
+	err   error // why there is no Eulerian cycle
+}
+
+// Computes an Eulerian cycle in the graph g, if one exists
+func NewEulerianCycle(g Graph) *EulerianCycle {
+	c := &EulerianCycle{}
+
+	// must have at least one edge
+	if g.E() == 0 {
+		c.err = ErrNoEdges
+		return c
+	}
+
+	// necessary condition: all vertices have even degree
+	// (this test is needed or it might find an Eulerian path instead of cycle)
+	for v := 0; v < g.V(); v++ {
+		if g.Degree(v)%2 != 0 {
+			c.err = fmt.Errorf("%w: vertex %d has odd degree %d", ErrDegreeImbalance, v, g.Degree(v))
+			return c
+		}
+	}
+
+	c.cycle = eulerianTrail(g, nonIsolatedVertex(g))
+	if c.cycle == nil {
+		c.err = ErrDisconnectedEdges
+	}
+
+	return c
+}
+
+// Returns the sequence of vertices on an Eulerian cycle, with the first and
+// the last vertex the same, or nil if no such cycle
+func (c *EulerianCycle) Cycle() []int {
+	return c.cycle
+}
+
+// Returns true if the graph has an Eulerian cycle
+func (c *EulerianCycle) HasEulerianCycle() bool {
+	return c.cycle != nil
+}
+
+// Returns why the graph has no Eulerian cycle, or nil if it has one
+func (c *EulerianCycle) Err() error {
+	return c.err
+}
+
+// Finds an Eulerian path in an undirected graph, if one exists
+type EulerianPath struct {
+	path []int // Eulerian path (or nil if no such path)
+	err  error // why there is no Eulerian path
+}
+
+// Computes an Eulerian path in the graph g, if one exists
+func NewEulerianPath(g Graph) *EulerianPath {
+	p := &EulerianPath{}
+
+	if g.V() == 0 {
+		p.err = ErrNoEdges
+		return p
+	}
+
+	// find vertex from which to start potential Eulerian path:
+	// a vertex v with odd degree(v) if it exists;
+	// otherwise a vertex with degree(v) > 0
+	oddDegreeVertices := 0
+	s := nonIsolatedVertex(g)
+	for v := 0; v < g.V(); v++ {
+		if g.Degree(v)%2 != 0 {
+			oddDegreeVertices++
+			s = v
+		}
+	}
+
+	// graph can't have an Eulerian path
+	// (this condition is needed for correctness)
+	if oddDegreeVertices > 2 {
+		p.err = fmt.Errorf("%w: %d vertices of odd degree", ErrDegreeImbalance, oddDegreeVertices)
+		return p
+	}
+
+	// special case for graph with zero edges (has a degenerate Eulerian path)
+	if s == -1 {
+		s = 0
+	}
+
+	p.path = eulerianTrail(g, s)
+	if p.path == nil {
+		p.err = ErrDisconnectedEdges
+	}
+
+	return p
+}
+
+// Returns the sequence of vertices on an Eulerian path,
+// or nil if no such path
+func (p *EulerianPath) Path() []int {
+	return p.path
+}
+
+// Returns true if the graph has an Eulerian path
+func (p *EulerianPath) HasEulerianPath() bool {
+	return p.path != nil
+}
+
+// Returns why the graph has no Eulerian path, or nil if it has one
+func (p *EulerianPath) Err() error {
+	return p.err
+}
+
+// an undirected edge, with a field to indicate whether the edge has
+// already been used
+type eulerianEdge struct {
+	v, w int
+	used bool
+}
+
+// returns the other vertex of the edge
+func (e *eulerianEdge) other(v int) int {
+	if v == e.v {
+		return e.w
+	}
+	return e.v
+}
+
+// Hierholzer's algorithm from vertex s. Returns nil if it does not use
+// every edge, i.e. the edges are not all connected.
+func eulerianTrail(g Graph, s int) []int {
+	// create local view of adjacency lists, to iterate one vertex at a time
+	// the helper eulerianEdge data type is used to avoid exploring both copies
+	// of an edge v-w
+	adj := make([][]*eulerianEdge, g.V())
+	for v := 0; v < g.V(); v++ {
+		selfLoops := 0
+		for _, ww := range g.Adj(v) {
+			w := ww.(int)
+			// careful with self loops
+			if v == w {
+				if selfLoops%2 == 0 {
+					adj[v] = append(adj[v], &eulerianEdge{v: v, w: w})
+				}
+				selfLoops++
+			} else if v < w {
+				e := &eulerianEdge{v: v, w: w}
+				adj[v] = append(adj[v], e)
+				adj[w] = append(adj[w], e)
+			}
+		}
+	}
+	next := make([]int, g.V()) // next[v]: index in adj[v] of the next edge to look at
+
+	// greedily search through edges in iterative DFS style
+	var trail []int
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for next[v] < len(adj[v]) {
+			e := adj[v][next[v]]
+			next[v]++
+			if e.used {
+				continue
+			}
+			e.used = true
+			stack = append(stack, v)
+			v = e.other(v)
+		}
+		// push vertex with no more leaving edges to trail
+		trail = append(trail, v)
+	}
+
+	// check if all edges are used
+	if len(trail) != g.E()+1 {
+		return nil
+	}
+
+	for i, j := 0, len(trail)-1; i < j; i, j = i+1, j-1 {
+		trail[i], trail[j] = trail[j], trail[i]
+	}
+	return trail
+}
+
+// returns any non-isolated vertex; -1 if no such vertex
+func nonIsolatedVertex(g Graph) int {
+	for v := 0; v < g.V(); v++ {
+		if g.Degree(v) > 0 {
+			return v
+		}
+	}
+	return -1
+}
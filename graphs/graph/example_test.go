@@ -2,6 +2,7 @@ package graph_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/youngzhu/algs4-go/fund"
 	"github.com/youngzhu/algs4-go/graphs/graph"
@@ -123,6 +124,48 @@ func ExampleGraph_HasEdge() {
 	// has edge 0-7: false
 }
 
+func ExampleGraph_RemoveEdge() {
+	in := testutil.NewInReadWords("testdata/tinyG.txt")
+	g := graph.NewGraph(in)
+
+	fmt.Println(g.RemoveEdge(6, 0), g.RemoveEdge(6, 0), g.HasEdge(0, 6))
+	fmt.Println(g.E(), g.Degree(0), g.Degree(6))
+
+	// Output:
+	// true false false
+	// 12 3 1
+}
+
+func ExampleGraph_RemoveVertex() {
+	in := testutil.NewInReadWords("testdata/tinyG.txt")
+	g := graph.NewGraph(in)
+
+	// the last vertex, 12, is renumbered 9
+	moved := g.RemoveVertex(9)
+	fmt.Printf("vertex %d is now vertex 9\n", moved)
+
+	v := g.AddVertex()
+	g.AddEdge(v, 0)
+	fmt.Print(g)
+
+	// Output:
+	// vertex 12 is now vertex 9
+	// 13 vertices, 11 edges
+	// 0: 12 6 2 1 5
+	// 1: 0
+	// 2: 0
+	// 3: 5 4
+	// 4: 5 6 3
+	// 5: 3 4 0
+	// 6: 0 4
+	// 7: 8
+	// 8: 7
+	// 9: 11
+	// 10:
+	// 11: 9
+	// 12: 0
+}
+
 func ExampleDepthFirstSearch() {
 	if tinyGraph == nil {
 		dataInit()
@@ -232,6 +275,37 @@ func ExampleBreadthFirstPaths() {
 
 }
 
+// the shortest paths from the nearest of several sources
+func ExampleNewBreadthFirstPathsMulti() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	bfs := graph.NewBreadthFirstPathsMulti(tinyGraph, []int{1, 3, 12})
+	for v := 0; v < tinyGraph.V(); v++ {
+		if bfs.HasPathTo(v) {
+			fmt.Printf("%d (%d): %v\n", v, bfs.DistTo(v), bfs.PathTo(v))
+		} else {
+			fmt.Printf("%d: not connected\n", v)
+		}
+	}
+
+	// Output:
+	// 0 (1): [1 0]
+	// 1 (0): [1]
+	// 2 (2): [1 0 2]
+	// 3 (0): [3]
+	// 4 (1): [3 4]
+	// 5 (1): [3 5]
+	// 6 (2): [1 0 6]
+	// 7: not connected
+	// 8: not connected
+	// 9 (1): [12 9]
+	// 10 (2): [12 9 10]
+	// 11 (1): [12 11]
+	// 12 (0): [12]
+}
+
 func ExampleConnectedComponents() {
 	if tinyGraph == nil {
 		dataInit()
@@ -269,6 +343,50 @@ func ExampleConnectedComponents() {
 
 }
 
+// the distances are those of BreadthFirstPaths, the paths may be other
+// shortest paths
+func ExampleParallelBreadthFirstPaths() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	bfs := graph.NewParallelBreadthFirstPaths(tinyGraph, 0, 4)
+	for v := 0; v < tinyGraph.V(); v++ {
+		if bfs.HasPathTo(v) {
+			fmt.Printf("0 to %d: %d edges\n", v, bfs.DistTo(v))
+		}
+	}
+
+	// Output:
+	// 0 to 0: 0 edges
+	// 0 to 1: 1 edges
+	// 0 to 2: 1 edges
+	// 0 to 3: 2 edges
+	// 0 to 4: 2 edges
+	// 0 to 5: 1 edges
+	// 0 to 6: 1 edges
+}
+
+func ExampleParallelConnectedComponents() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	cc := graph.NewParallelConnectedComponents(tinyGraph, 4)
+	fmt.Printf("%d components\n", cc.Count())
+	ids := make([]int, tinyGraph.V())
+	for v := range ids {
+		ids[v] = cc.Id(v)
+	}
+	fmt.Println(ids)
+	fmt.Println(cc.Size(0), cc.Size(7), cc.Connected(9, 12))
+
+	// Output:
+	// 3 components
+	// [0 0 0 0 0 0 0 1 1 2 2 2 2]
+	// 7 2 true
+}
+
 func ExampleSymbolGraph_routes() {
 	sg := graph.NewSymbolGraph("testdata/routes.txt", " ")
 
@@ -292,6 +410,30 @@ func ExampleSymbolGraph_movies() {
 
 }
 
+func ExampleReadSymbolGraph() {
+	in := "JFK ORD ATL\nORD DEN\n"
+	sg, err := graph.ReadSymbolGraph(strings.NewReader(in), " ")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(sg.AddVertex("ORD"), sg.AddVertex("LAX"))
+	sg.AddEdge("DEN", "LAX")
+	fmt.Println(sg.Lookup("LAX"))
+	fmt.Println(sg.Lookup("KFC"))
+
+	g := sg.Graph()
+	bfs := graph.NewBreadthFirstPaths(g, sg.Index("JFK"))
+	fmt.Println(sg.Names(bfs.PathTo(sg.Index("LAX"))))
+
+	// Output:
+	// 1 4
+	// 4 true
+	// -1 false
+	// [JFK ORD DEN LAX]
+}
+
 func symbolGraph(sg graph.SymbolGraph, input string) {
 	fmt.Println(input)
 
@@ -305,3 +447,276 @@ func symbolGraph(sg graph.SymbolGraph, input string) {
 		fmt.Printf("    input not contain '%v'\n", input)
 	}
 }
+
+func ExampleCycle() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	finder := graph.NewCycle(*tinyGraph)
+	if finder.HasCycle() {
+		for _, v := range finder.Cycle() {
+			fmt.Printf("%d ", v)
+		}
+	} else {
+		fmt.Print("Graph is acyclic")
+	}
+
+	// Output:
+	// 3 4 5 3
+}
+
+func ExampleCycle_acyclic() {
+	// a tree and an isolated vertex
+	g := graph.NewGraphN(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+
+	finder := graph.NewCycle(*g)
+	fmt.Println(finder.HasCycle(), finder.Check())
+
+	g.AddEdge(1, 3)
+	finder = graph.NewCycle(*g)
+	fmt.Println(finder.Cycle(), finder.Check())
+
+	// Output:
+	// false <nil>
+	// [1 0 2 3 1] <nil>
+}
+
+func ExampleCycle_parallelEdges() {
+	g := graph.NewGraphN(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+
+	finder := graph.NewCycle(*g)
+	fmt.Println(finder.Cycle(), finder.Check())
+
+	g.AddEdge(0, 0)
+	finder = graph.NewCycle(*g)
+	fmt.Println(finder.Cycle(), finder.Check())
+
+	// Output:
+	// [1 2 1] <nil>
+	// [0 0] <nil>
+}
+
+func ExampleBipartite() {
+	// the vertices 0-5 are jobs, an edge is a scheduling conflict
+	g := graph.NewGraphN(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	g.AddEdge(4, 5)
+
+	b := graph.NewBipartite(*g)
+	fmt.Println("bipartite:", b.IsBipartite())
+	for v := 0; v < g.V(); v++ {
+		fmt.Printf("%d: %v\n", v, b.Color(v))
+	}
+	fmt.Println(b.Check())
+
+	// Output:
+	// bipartite: true
+	// 0: false
+	// 1: true
+	// 2: false
+	// 3: true
+	// 4: false
+	// 5: true
+	// <nil>
+}
+
+func ExampleBipartite_oddCycle() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	b := graph.NewBipartite(*tinyGraph)
+	fmt.Println("bipartite:", b.IsBipartite())
+	fmt.Println("odd cycle:", b.OddCycle())
+	fmt.Println(b.Check())
+
+	// Output:
+	// bipartite: false
+	// odd cycle: [4 5 3 4]
+	// <nil>
+}
+
+func ExampleBiconnected() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	bc := graph.NewBiconnected(*tinyGraph)
+	fmt.Println("articulation points:", bc.ArticulationPoints())
+	for i := 0; i < bc.Count(); i++ {
+		fmt.Println(bc.Block(i))
+	}
+
+	// Output:
+	// articulation points: [0 9]
+	// [0 3 4 5 6]
+	// [0 2]
+	// [0 1]
+	// [7 8]
+	// [9 11 12]
+	// [9 10]
+}
+
+func ExampleBridge() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	b := graph.NewBridge(*tinyGraph)
+	fmt.Println("bridges:", b.Bridges())
+	fmt.Println(b.Count(), "2-edge-connected components")
+	fmt.Println(b.IsBridge(9, 10), b.IsBridge(9, 11))
+
+	// Output:
+	// bridges: [[0 2] [0 1] [7 8] [9 10]]
+	// 7 2-edge-connected components
+	// true false
+}
+
+func ExampleBlockCutTree() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	t := graph.NewBlockCutTree(*tinyGraph)
+	tree := t.Tree()
+	for x := 0; x < tree.V(); x++ {
+		if t.IsBlock(x) {
+			fmt.Printf("block %d %v:", x, t.Block(x))
+		} else {
+			fmt.Printf("cut %d (%d):", x, t.CutVertex(x))
+		}
+		for _, y := range tree.Adj(x) {
+			fmt.Printf(" %d", y)
+		}
+		fmt.Println()
+	}
+	fmt.Println(t.Node(9), t.Node(10))
+
+	// Output:
+	// block 0 [0 3 4 5 6]: 6
+	// block 1 [0 2]: 6
+	// block 2 [0 1]: 6
+	// block 3 [7 8]:
+	// block 4 [9 11 12]: 7
+	// block 5 [9 10]: 7
+	// cut 6 (0): 2 1 0
+	// cut 7 (9): 5 4
+	// 7 5
+}
+
+func ExampleSymbolGraph_biconnected() {
+	sg := graph.NewSymbolGraph("testdata/network.txt", " ")
+	g := sg.Graph()
+
+	bc := graph.NewBiconnected(g)
+	fmt.Println("articulation points:", sg.Names(bc.ArticulationPoints()))
+
+	for _, e := range graph.NewBridge(g).Bridges() {
+		fmt.Println("bridge:", sg.Name(e[0]), sg.Name(e[1]))
+	}
+
+	// Output:
+	// articulation points: [core gw dc2]
+	// bridge: dc2 backup
+	// bridge: core gw
+	// bridge: lab lab2
+}
+
+func ExampleEulerianCycle() {
+	// two triangles sharing vertex 0, a self-loop and two parallel edges
+	g := graph.NewGraphN(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 0)
+	g.AddEdge(2, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 3)
+
+	ec := graph.NewEulerianCycle(*g)
+	fmt.Println(ec.Cycle(), ec.Err())
+
+	g.AddEdge(1, 3)
+	ec = graph.NewEulerianCycle(*g)
+	fmt.Println(ec.HasEulerianCycle(), ec.Err())
+
+	// Output:
+	// [0 4 3 4 3 0 2 2 1 0] <nil>
+	// false degree imbalance: vertex 1 has odd degree 3
+}
+
+func ExampleEulerianPath() {
+	if tinyGraph == nil {
+		dataInit()
+	}
+
+	ep := graph.NewEulerianPath(*tinyGraph)
+	fmt.Println(ep.HasEulerianPath(), ep.Err())
+
+	g := graph.NewGraphN(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	ep = graph.NewEulerianPath(*g)
+	fmt.Println(ep.Path(), ep.Err())
+
+	// Output:
+	// false degree imbalance: 8 vertices of odd degree
+	// [3 2 0 1 2] <nil>
+}
+
+func ExampleGraphGenerator() {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := graph.NewGraphGenerator(r)
+
+	tree := gen.Tree(100)
+	fmt.Println("tree:", tree.E(), graph.NewConnectedComponents(*tree).Count(), graph.NewCycle(*tree).HasCycle())
+
+	bipartite := gen.Bipartite(20, 30, 200)
+	fmt.Println("bipartite:", bipartite.E(), graph.NewBipartite(*bipartite).IsBipartite())
+
+	regular := gen.Regular(10, 4)
+	fmt.Println("regular:", regular.E(), regular.MaxDegree())
+
+	wheel := gen.Wheel(8)
+	fmt.Println("wheel:", wheel.E(), wheel.MaxDegree(), graph.NewBiconnected(*wheel).Count())
+
+	path := gen.Path(5)
+	fmt.Println("path:", graph.NewEulerianPath(*path).HasEulerianPath(), len(graph.NewBridge(*path).Bridges()))
+
+	// Output:
+	// tree: 99 1 false
+	// bipartite: 200 true
+	// regular: 20 4
+	// wheel: 14 7 1
+	// path: true 4
+}
+
+func ExampleGraphGenerator_seed() {
+	r := testutil.NewRandom()
+
+	r.Seed(2022)
+	g1 := graph.NewGraphGenerator(r).ErdosRenyi(20, 0.2)
+	r.Seed(2022)
+	g2 := graph.NewGraphGenerator(r).ErdosRenyi(20, 0.2)
+
+	fmt.Println(g1.E(), g1.String() == g2.String())
+
+	// Output:
+	// 31 true
+}
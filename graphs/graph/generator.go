@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"github.com/youngzhu/algs4-go/sorting/pq"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// Graph generators.
+// Random and structured graphs, to test and benchmark graph algorithms
+// without reading a file. All the randomness comes from the testutil.Random
+// of the generator, so seeding it makes the graphs reproducible. The vertices
+// of the structured graphs (paths, cycles, trees, ...) are in random order,
+// so that an algorithm can not take advantage of a convenient numbering.
+
+type GraphGenerator struct {
+	r *testutil.Random
+}
+
+// NewGraphGenerator returns a generator using r for the random choices.
+// If r is nil, a new (time-seeded) testutil.Random is used.
+func NewGraphGenerator(r *testutil.Random) *GraphGenerator {
+	if r == nil {
+		r = testutil.NewRandom()
+	}
+	return &GraphGenerator{r}
+}
+
+// Returns a random simple graph containing v vertices and e edges,
+// i.e. no self-loops or parallel edges, chosen uniformly at random
+func (gen *GraphGenerator) Simple(v, e int) *Graph {
+	if e > v*(v-1)/2 {
+		panic("too many edges")
+	}
+	if e < 0 {
+		panic("too few edges")
+	}
+
+	g := NewGraphN(v)
+	set := make(map[[2]int]bool)
+	for g.E() < e {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		if x > y {
+			x, y = y, x
+		}
+		if x != y && !set[[2]int{x, y}] {
+			set[[2]int{x, y}] = true
+			g.AddEdge(x, y)
+		}
+	}
+	return g
+}
+
+// Returns a random simple graph on v vertices, with an edge between any two
+// vertices with probability p. This is sometimes referred to as the
+// Erdős–Rényi random graph model.
+func (gen *GraphGenerator) ErdosRenyi(v int, p float64) *Graph {
+	if p < 0.0 || p > 1.0 {
+		panic("probability must be between 0 and 1")
+	}
+
+	g := NewGraphN(v)
+	for x := 0; x < v; x++ {
+		for y := x + 1; y < v; y++ {
+			if gen.r.Float64() < p {
+				g.AddEdge(x, y)
+			}
+		}
+	}
+	return g
+}
+
+// Returns the complete graph on v vertices
+func (gen *GraphGenerator) Complete(v int) *Graph {
+	return gen.ErdosRenyi(v, 1.0)
+}
+
+// Returns a complete bipartite graph on v1 and v2 vertices
+func (gen *GraphGenerator) CompleteBipartite(v1, v2 int) *Graph {
+	return gen.Bipartite(v1, v2, v1*v2)
+}
+
+// Returns a random simple bipartite graph on v1 and v2 vertices with e edges
+func (gen *GraphGenerator) Bipartite(v1, v2, e int) *Graph {
+	if e > v1*v2 {
+		panic("too many edges")
+	}
+	if e < 0 {
+		panic("too few edges")
+	}
+
+	g := NewGraphN(v1 + v2)
+	vertices := gen.r.Perm(v1 + v2)
+	set := make(map[[2]int]bool)
+	for g.E() < e {
+		i := gen.r.UniformIntN(v1)
+		j := v1 + gen.r.UniformIntN(v2)
+		if !set[[2]int{i, j}] {
+			set[[2]int{i, j}] = true
+			g.AddEdge(vertices[i], vertices[j])
+		}
+	}
+	return g
+}
+
+// Returns a random simple bipartite graph on v1 and v2 vertices, containing
+// each possible edge with probability p
+func (gen *GraphGenerator) BipartiteP(v1, v2 int, p float64) *Graph {
+	if p < 0.0 || p > 1.0 {
+		panic("probability must be between 0 and 1")
+	}
+
+	g := NewGraphN(v1 + v2)
+	vertices := gen.r.Perm(v1 + v2)
+	for i := 0; i < v1; i++ {
+		for j := 0; j < v2; j++ {
+			if gen.r.Float64() < p {
+				g.AddEdge(vertices[i], vertices[v1+j])
+			}
+		}
+	}
+	return g
+}
+
+// Returns a path graph on v vertices
+func (gen *GraphGenerator) Path(v int) *Graph {
+	g := NewGraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	return g
+}
+
+// Returns a complete binary tree graph on v vertices
+func (gen *GraphGenerator) BinaryTree(v int) *Graph {
+	g := NewGraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 1; i < v; i++ {
+		g.AddEdge(vertices[i], vertices[(i-1)/2])
+	}
+	return g
+}
+
+// Returns a cycle graph on v vertices
+func (gen *GraphGenerator) Cycle(v int) *Graph {
+	g := NewGraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 0; i < v-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	if v > 0 {
+		g.AddEdge(vertices[v-1], vertices[0])
+	}
+	return g
+}
+
+// Returns an Eulerian cycle graph on v vertices with e edges: a random
+// closed walk of length e, which may repeat edges and use self-loops
+func (gen *GraphGenerator) EulerianCycle(v, e int) *Graph {
+	if e <= 0 {
+		panic("an Eulerian cycle must have at least one edge")
+	}
+	if v <= 0 {
+		panic("an Eulerian cycle must have at least one vertex")
+	}
+
+	g := NewGraphN(v)
+	vertices := make([]int, e)
+	for i := range vertices {
+		vertices[i] = gen.r.UniformIntN(v)
+	}
+	for i := 0; i < e-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	g.AddEdge(vertices[e-1], vertices[0])
+	return g
+}
+
+// Returns an Eulerian path graph on v vertices with e edges: a random walk
+// of length e, which may repeat edges and use self-loops
+func (gen *GraphGenerator) EulerianPath(v, e int) *Graph {
+	if e < 0 {
+		panic("negative number of edges")
+	}
+	if v <= 0 {
+		panic("an Eulerian path must have at least one vertex")
+	}
+
+	g := NewGraphN(v)
+	vertices := make([]int, e+1)
+	for i := range vertices {
+		vertices[i] = gen.r.UniformIntN(v)
+	}
+	for i := 0; i < e; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	return g
+}
+
+// Returns a wheel graph on v vertices: a cycle on v-1 vertices and a hub
+// adjacent to all of them
+func (gen *GraphGenerator) Wheel(v int) *Graph {
+	if v <= 1 {
+		panic("number of vertices must be at least 2")
+	}
+
+	g := NewGraphN(v)
+	vertices := gen.r.Perm(v)
+
+	// simple cycle on v-1 vertices
+	for i := 1; i < v-1; i++ {
+		g.AddEdge(vertices[i], vertices[i+1])
+	}
+	g.AddEdge(vertices[v-1], vertices[1])
+
+	// connect vertices[0] to every vertex on the cycle
+	for i := 1; i < v; i++ {
+		g.AddEdge(vertices[0], vertices[i])
+	}
+	return g
+}
+
+// Returns a star graph on v vertices: a center adjacent to all the others
+func (gen *GraphGenerator) Star(v int) *Graph {
+	if v <= 0 {
+		panic("number of vertices must be at least 1")
+	}
+
+	g := NewGraphN(v)
+	vertices := gen.r.Perm(v)
+	for i := 1; i < v; i++ {
+		g.AddEdge(vertices[0], vertices[i])
+	}
+	return g
+}
+
+// Returns a uniformly random k-regular graph on v vertices
+// (not necessarily simple). v*k must be even.
+func (gen *GraphGenerator) Regular(v, k int) *Graph {
+	if v*k%2 != 0 {
+		panic("number of vertices * k must be even")
+	}
+
+	g := NewGraphN(v)
+
+	// create k copies of each vertex, and match them at random
+	vertices := make([]int, v*k)
+	for x := 0; x < v; x++ {
+		for i := 0; i < k; i++ {
+			vertices[x+v*i] = x
+		}
+	}
+	gen.r.Shuffle(len(vertices), func(i, j int) {
+		vertices[i], vertices[j] = vertices[j], vertices[i]
+	})
+
+	for i := 0; i < v*k/2; i++ {
+		g.AddEdge(vertices[2*i], vertices[2*i+1])
+	}
+	return g
+}
+
+// Returns a uniformly random tree on v vertices.
+// This algorithm uses a Prüfer sequence and takes time proportional to V log V.
+func (gen *GraphGenerator) Tree(v int) *Graph {
+	g := NewGraphN(v)
+
+	// special case
+	if v <= 1 {
+		return g
+	}
+
+	// Cayley's theorem: there are V^(V-2) labeled trees on V vertices
+	// Prüfer sequence: sequence of V-2 values between 0 and V-1
+	// Prüfer's proof of Cayley's theorem: Prüfer sequences are in 1-1
+	// with labeled trees on V vertices
+	prufer := make([]int, v-2)
+	for i := range prufer {
+		prufer[i] = gen.r.UniformIntN(v)
+	}
+
+	// degree of vertex v = 1 + number of times it appears in Prüfer sequence
+	degree := make([]int, v)
+	for x := range degree {
+		degree[x] = 1
+	}
+	for _, x := range prufer {
+		degree[x]++
+	}
+
+	// pq contains all vertices of degree 1
+	leaves := pq.NewMinPQ()
+	for x := 0; x < v; x++ {
+		if degree[x] == 1 {
+			leaves.Insert(pq.IntItem(x))
+		}
+	}
+
+	// repeatedly delMin() degree 1 vertex that has the minimum index
+	for _, y := range prufer {
+		x := int(leaves.Delete().(pq.IntItem))
+		g.AddEdge(x, y)
+		degree[x]--
+		degree[y]--
+		if degree[y] == 1 {
+			leaves.Insert(pq.IntItem(y))
+		}
+	}
+	g.AddEdge(int(leaves.Delete().(pq.IntItem)), int(leaves.Delete().(pq.IntItem)))
+	return g
+}
@@ -22,9 +22,9 @@ type IGraph interface {
 // implemented using an array of set.
 // Parallel edges and self-loops allowed
 type Graph struct {
-	v   int         // number of vertices
-	e   int         // number of edges
-	adj []*fund.Bag //
+	v   int          // number of vertices
+	e   int          // number of edges
+	adj []*fund.List //
 }
 
 // NewGraph
@@ -38,9 +38,9 @@ func NewGraph(in *testutil.In) *Graph {
 		panic("number of verties in a Graph must be non-negative")
 	}
 
-	adj := make([]*fund.Bag, v)
+	adj := make([]*fund.List, v)
 	for i := 0; i < v; i++ {
-		adj[i] = fund.NewBag()
+		adj[i] = fund.NewList()
 	}
 
 	g := &Graph{v, 0, adj}
@@ -65,9 +65,9 @@ func NewGraphN(v int) *Graph {
 		panic("number of vertices in a Graph must be non-negative")
 	}
 
-	adj := make([]*fund.Bag, v)
+	adj := make([]*fund.List, v)
 	for i := 0; i < v; i++ {
-		adj[i] = fund.NewBag()
+		adj[i] = fund.NewList()
 	}
 
 	return &Graph{v, 0, adj}
@@ -83,9 +83,9 @@ func NewGraphCopy(g Graph) *Graph {
 		panic("number of vertices in a Graph must be non-negative")
 	}
 
-	adj := make([]*fund.Bag, v)
+	adj := make([]*fund.List, v)
 	for i := 0; i < v; i++ {
-		adj[i] = fund.NewBag()
+		adj[i] = fund.NewList()
 	}
 
 	for i := 0; i < v; i++ {
@@ -200,3 +200,59 @@ func (g *Graph) HasEdge(v, w int) bool {
 	}
 	return false
 }
+
+// RemoveEdge removes one undirected edge v-w from this graph.
+// Returns false if there is no such edge.
+func (g *Graph) RemoveEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.adj[v].Remove(w) {
+		return false
+	}
+	// a self-loop appears in the adjacency list twice
+	g.adj[w].Remove(v)
+	g.e--
+	return true
+}
+
+// AddVertex adds an isolated vertex to this graph and returns it
+func (g *Graph) AddVertex() int {
+	g.adj = append(g.adj, fund.NewList())
+	g.v++
+	return g.v - 1
+}
+
+// RemoveVertex removes the vertex v and the edges incident on it.
+// The vertices stay numbered 0 to V-1: the last vertex takes the number v.
+// Returns the number the vertex now numbered v had before, which is v
+// itself if v was the last vertex.
+func (g *Graph) RemoveVertex(v int) int {
+	g.validateVertex(v)
+
+	selfLoops := 0
+	for _, it := range g.adj[v].Iterator() {
+		w := it.(int)
+		if w == v {
+			selfLoops++
+		} else {
+			g.adj[w].Remove(v)
+			g.e--
+		}
+	}
+	g.e -= selfLoops / 2
+
+	last := g.v - 1
+	if v != last {
+		for _, it := range g.adj[last].Iterator() {
+			// w is last for self-loops, which are renumbered in place
+			w := it.(int)
+			g.adj[w].Replace(last, v)
+		}
+		g.adj[v] = g.adj[last]
+	}
+
+	g.adj[last] = nil
+	g.adj = g.adj[:last]
+	g.v--
+	return last
+}
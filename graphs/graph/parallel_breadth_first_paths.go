@@ -0,0 +1,225 @@
+package graph
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/youngzhu/algs4-go/graphs"
+)
+
+// Parallel breadth-first search.
+// A level-synchronous search: the vertices at distance d from the source
+// (the frontier) are expanded together by several goroutines, and the next
+// frontier is complete before it is expanded in turn. The search is
+// direction-optimizing (Beamer, Asanović and Patterson):
+// - top-down steps scan the adjacency lists of the frontier, and claim each
+//   unmarked vertex with a compare-and-swap, as long as the frontier is small;
+// - bottom-up steps scan the unmarked vertices instead, each looking for a
+//   neighbor in the frontier and stopping at the first one found, which is
+//   cheaper once the frontier has more edges than the rest of the graph.
+// The distances are those of BreadthFirstPaths. The shortest paths may be
+// other ones, as the first goroutine to claim a vertex sets its edgeTo.
+// Bottom-up steps need undirected adjacency lists: in a digraph (a graph
+// with an Indegree method, or a Directed method that returns true), all
+// steps are top-down.
+
+const (
+	// go bottom-up when the frontier has more than 1/alpha of the
+	// unexplored edges, and back top-down when it has less than 1/beta of
+	// the vertices
+	bfsAlpha = 14
+	bfsBeta  = 24
+)
+
+type ParallelBreadthFirstPaths struct {
+	source int
+	distTo []int32 // distTo[v]: number of edges on shortest s-v path, -1 if none
+	edgeTo []int   // edgeTo[v]: previous vertex on a shortest s-v path
+}
+
+// Computes the shortest paths between the source vertex (s) and every other
+// vertex in graph g, with the given number of goroutines, or GOMAXPROCS
+// goroutines if workers <= 0
+func NewParallelBreadthFirstPaths(g IGraph, s, workers int) ParallelBreadthFirstPaths {
+	n := g.V()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	p := ParallelBreadthFirstPaths{s, make([]int32, n), make([]int, n)}
+	p.validateVertex(s)
+	for v := range p.distTo {
+		p.distTo[v] = -1
+	}
+
+	degree := degrees(g, workers)
+	unexplored := 0
+	for _, d := range degree {
+		unexplored += d
+	}
+	bottomUpAllowed := !directed(g)
+
+	p.distTo[s] = 0
+	frontier := []int{s}
+	frontierEdges := degree[s]
+	unexplored -= degree[s]
+	bottomUp := false
+
+	for level := int32(0); len(frontier) > 0; level++ {
+		if bottomUpAllowed {
+			if !bottomUp && frontierEdges > unexplored/bfsAlpha {
+				bottomUp = true
+			} else if bottomUp && len(frontier) < n/bfsBeta {
+				bottomUp = false
+			}
+		}
+
+		if bottomUp {
+			frontier = p.bottomUp(g, level, workers)
+		} else {
+			frontier = p.topDown(g, frontier, level, workers)
+		}
+
+		frontierEdges = 0
+		for _, v := range frontier {
+			frontierEdges += degree[v]
+		}
+		unexplored -= frontierEdges
+	}
+
+	return p
+}
+
+// expands the frontier at distance level, returns the next frontier
+func (p ParallelBreadthFirstPaths) topDown(g IGraph, frontier []int, level int32, workers int) []int {
+	next := make([][]int, workers)
+	parallel(len(frontier), workers, func(worker, i, j int) {
+		for _, v := range frontier[i:j] {
+			adj := Adjacent(g, v)
+			for k := 0; k < adj.Len(); k++ {
+				w := adj.At(k)
+				if atomic.LoadInt32(&p.distTo[w]) == -1 && atomic.CompareAndSwapInt32(&p.distTo[w], -1, level+1) {
+					p.edgeTo[w] = v
+					next[worker] = append(next[worker], w)
+				}
+			}
+		}
+	})
+	return concat(next)
+}
+
+// finds the unmarked vertices adjacent to the frontier at distance level,
+// returns them as the next frontier
+func (p ParallelBreadthFirstPaths) bottomUp(g IGraph, level int32, workers int) []int {
+	next := make([][]int, workers)
+	parallel(len(p.distTo), workers, func(worker, i, j int) {
+		// only this goroutine writes distTo[v] for v in [i, j)
+		for v := i; v < j; v++ {
+			if p.distTo[v] != -1 {
+				continue
+			}
+			adj := Adjacent(g, v)
+			for k := 0; k < adj.Len(); k++ {
+				w := adj.At(k)
+				if atomic.LoadInt32(&p.distTo[w]) == level {
+					atomic.StoreInt32(&p.distTo[v], level+1)
+					p.edgeTo[v] = w
+					next[worker] = append(next[worker], v)
+					break
+				}
+			}
+		}
+	})
+	return concat(next)
+}
+
+// Is there a path between the source vertex (s) and vertex (v)
+func (p ParallelBreadthFirstPaths) HasPathTo(v int) bool {
+	p.validateVertex(v)
+	return p.distTo[v] >= 0
+}
+
+// Returns the number of edges in a shortest path between the source vertex s
+// and vertex v, as BreadthFirstPaths does
+func (p ParallelBreadthFirstPaths) DistTo(v int) int {
+	if !p.HasPathTo(v) {
+		return graphs.InfinityDistance
+	}
+	return int(p.distTo[v])
+}
+
+// Returns a shortest path between the source vertex (s) and vertex v
+// or nil if no such path
+func (p ParallelBreadthFirstPaths) PathTo(v int) []int {
+	if !p.HasPathTo(v) {
+		return nil
+	}
+
+	path := make([]int, p.distTo[v]+1)
+	for i, x := len(path)-1, v; i >= 0; i, x = i-1, p.edgeTo[x] {
+		path[i] = x
+	}
+	return path
+}
+
+func (p ParallelBreadthFirstPaths) validateVertex(v int) {
+	if v < 0 || v >= len(p.distTo) {
+		panic("invalidate vertex")
+	}
+}
+
+// parallel splits 0 to n into ranges [i, j), one per worker, and runs
+// f(worker, i, j) for all of them concurrently
+func parallel(n, workers int, f func(worker, i, j int)) {
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		i, j := n*worker/workers, n*(worker+1)/workers
+		if i == j {
+			continue
+		}
+		wg.Add(1)
+		go func(worker, i, j int) {
+			defer wg.Done()
+			f(worker, i, j)
+		}(worker, i, j)
+	}
+	wg.Wait()
+}
+
+func concat(lists [][]int) []int {
+	size := 0
+	for _, l := range lists {
+		size += len(l)
+	}
+	all := make([]int, 0, size)
+	for _, l := range lists {
+		all = append(all, l...)
+	}
+	return all
+}
+
+// returns the degrees (outdegrees in a digraph) of the vertices of g
+func degrees(g IGraph, workers int) []int {
+	degree := make([]int, g.V())
+	d, hasDegree := g.(interface{ Degree(v int) int })
+	parallel(len(degree), workers, func(_, i, j int) {
+		for v := i; v < j; v++ {
+			if hasDegree {
+				degree[v] = d.Degree(v)
+			} else {
+				degree[v] = Adjacent(g, v).Len()
+			}
+		}
+	})
+	return degree
+}
+
+// is g a digraph?
+func directed(g IGraph) bool {
+	if d, ok := g.(interface{ Directed() bool }); ok {
+		return d.Directed()
+	}
+	_, ok := g.(interface{ Indegree(v int) int })
+	return ok
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"runtime"
+
+	"github.com/youngzhu/algs4-go/fund/uf"
+)
+
+// Parallel connected components.
+// Several goroutines scan the adjacency lists of ranges of vertices and
+// union the endpoints of every edge in a concurrent union-find (see
+// uf.ConcurrentUF). The components are then numbered in the order of their
+// smallest vertex, which is the numbering of ConnectedComponents, so Id gives
+// the same results. The graph must be undirected.
+
+type ParallelConnectedComponents struct {
+	id    []int // id[v]: id of connected component containing v
+	size  []int // size[id]: number of vertices in given component
+	count int   // number of connected components
+}
+
+// Computes the connected components of graph g, with the given number of
+// goroutines, or GOMAXPROCS goroutines if workers <= 0
+func NewParallelConnectedComponents(g IGraph, workers int) ParallelConnectedComponents {
+	n := g.V()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	u := uf.NewConcurrentUF(n)
+	parallel(n, workers, func(_, i, j int) {
+		for v := i; v < j; v++ {
+			adj := Adjacent(g, v)
+			for k := 0; k < adj.Len(); k++ {
+				// each edge v-w is also in the adjacency list of w
+				if w := adj.At(k); w > v {
+					u.Union(v, w)
+				}
+			}
+		}
+	})
+
+	cc := ParallelConnectedComponents{make([]int, n), make([]int, u.Count()), u.Count()}
+	rootID := make([]int, n) // rootID[root]: id of the component + 1, 0 if not numbered yet
+	next := 0
+	for v := 0; v < n; v++ {
+		root := u.Find(v)
+		if rootID[root] == 0 {
+			next++
+			rootID[root] = next
+		}
+		cc.id[v] = rootID[root] - 1
+		cc.size[cc.id[v]]++
+	}
+
+	return cc
+}
+
+// Returns the component id of the connected component containing vertex v
+func (cc ParallelConnectedComponents) Id(v int) int {
+	cc.validateVertex(v)
+	return cc.id[v]
+}
+
+// Returns the number of vertices in the connected component containing vertex v
+func (cc ParallelConnectedComponents) Size(v int) int {
+	cc.validateVertex(v)
+	return cc.size[cc.id[v]]
+}
+
+// Returns the number of connected components in the graph
+func (cc ParallelConnectedComponents) Count() int {
+	return cc.count
+}
+
+// Returns true if vertices v and w are in the same connected component
+func (cc ParallelConnectedComponents) Connected(v, w int) bool {
+	cc.validateVertex(v)
+	cc.validateVertex(w)
+	return cc.id[v] == cc.id[w]
+}
+
+func (cc ParallelConnectedComponents) validateVertex(v int) {
+	if v < 0 || v >= len(cc.id) {
+		panic("invalidate vertex")
+	}
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// generated graphs of various shapes: deep (path), shallow (star, complete),
+// dense enough for bottom-up steps, and disconnected
+func parallelTestGraphs() map[string]*Graph {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gen := NewGraphGenerator(r)
+
+	graphs := map[string]*Graph{
+		"simple":     gen.Simple(2000, 3000),
+		"sparse":     gen.Simple(2000, 1000),
+		"erdosRenyi": gen.ErdosRenyi(500, 0.05),
+		"complete":   gen.Complete(100),
+		"path":       gen.Path(3000),
+		"star":       gen.Star(1000),
+		"tree":       gen.Tree(2000),
+		"regular":    gen.Regular(1000, 4),
+		"empty":      NewGraphN(10),
+	}
+
+	// parallel edges and self-loops
+	multi := NewGraphN(500)
+	for i := 0; i < 1500; i++ {
+		multi.AddEdge(r.Intn(500), r.Intn(500))
+	}
+	graphs["multi"] = multi
+
+	return graphs
+}
+
+func TestParallelBreadthFirstPaths(t *testing.T) {
+	for name, g := range parallelTestGraphs() {
+		for _, workers := range []int{1, 3, 8} {
+			for _, s := range []int{0, g.V() / 2} {
+				want := NewBreadthFirstPaths(*g, s)
+				got := NewParallelBreadthFirstPaths(g, s, workers)
+				for v := 0; v < g.V(); v++ {
+					if got.DistTo(v) != want.DistTo(v) || got.HasPathTo(v) != want.HasPathTo(v) {
+						t.Fatalf("%s, %d workers: distTo(%d) = %d, want %d", name, workers, v, got.DistTo(v), want.DistTo(v))
+					}
+					if err := checkPath(g, s, v, got.PathTo(v), want.DistTo(v)); got.HasPathTo(v) && err != nil {
+						t.Fatalf("%s, %d workers: pathTo(%d): %v", name, workers, v, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// path must be a path of the given length from s to v
+func checkPath(g *Graph, s, v int, path []int, length int) error {
+	if len(path) != length+1 || path[0] != s || path[len(path)-1] != v {
+		return fmt.Errorf("%v is not a path of length %d from %d to %d", path, length, s, v)
+	}
+	for i := 1; i < len(path); i++ {
+		if !g.HasEdge(path[i-1], path[i]) {
+			return fmt.Errorf("%v: no edge %d-%d", path, path[i-1], path[i])
+		}
+	}
+	return nil
+}
+
+func TestParallelConnectedComponents(t *testing.T) {
+	for name, g := range parallelTestGraphs() {
+		want := NewConnectedComponents(*g)
+		for _, workers := range []int{1, 3, 8} {
+			got := NewParallelConnectedComponents(g, workers)
+			if got.Count() != want.Count() {
+				t.Fatalf("%s, %d workers: count = %d, want %d", name, workers, got.Count(), want.Count())
+			}
+			for v := 0; v < g.V(); v++ {
+				if got.Id(v) != want.Id(v) || got.Size(v) != want.Size(v) {
+					t.Fatalf("%s, %d workers: id(%d), size(%d) = %d, %d, want %d, %d",
+						name, workers, v, v, got.Id(v), got.Size(v), want.Id(v), want.Size(v))
+				}
+			}
+		}
+	}
+}
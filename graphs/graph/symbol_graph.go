@@ -1,8 +1,9 @@
 package graph
 
 import (
-	"strings"
+	"io"
 
+	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/searching"
 	"github.com/youngzhu/algs4-go/testutil"
 )
@@ -32,46 +33,77 @@ type SymbolGraph struct {
 // Each line in the file contains the name of a vertex,
 // followed by a list of the names of the vertices adjacent to that vertex,
 // separated by the delimiter.
+// Panics if the file cannot be read, see ReadSymbolGraph.
 func NewSymbolGraph(filepath, delimiter string) SymbolGraph {
-	st := searching.NewRedBlackBST()
-
-	// first pass builds the index by reading strings to associate
-	// distinct strings with an index
-	in := testutil.NewInReadLines(filepath)
-	for in.HasNext() {
-		slice := strings.Split(in.ReadLine(), delimiter)
-		for _, v := range slice {
-			key := searching.StringKey(v)
-			if !st.Contains(key) {
-				st.Put(key, st.Size())
-			}
-		}
+	r, err := testutil.NewReader(filepath)
+	if err != nil {
+		panic(err)
 	}
+	defer r.Close()
+	sg, err := ReadSymbolGraph(r, delimiter)
+	if err != nil {
+		panic(err)
+	}
+	return *sg
+}
+
+// Reads a Symbol Graph in the format of NewSymbolGraph, in a single pass:
+// the vertices are numbered in the order their names first appear
+func ReadSymbolGraph(r io.Reader, delimiter string) (*SymbolGraph, error) {
+	sg := newSymbolGraph()
+	if err := graphs.ReadSymbolLines(r, delimiter, sg.addLine); err != nil {
+		return nil, err
+	}
+	return sg, nil
+}
+
+// Reads a Symbol Graph in CSV format, with the comma as delimiter: the names
+// may be quoted, see graphs.ReadSymbolCSV
+func ReadSymbolGraphCSV(r io.Reader, comma rune) (*SymbolGraph, error) {
+	sg := newSymbolGraph()
+	if err := graphs.ReadSymbolCSV(r, comma, sg.addLine); err != nil {
+		return nil, err
+	}
+	return sg, nil
+}
+
+func newSymbolGraph() *SymbolGraph {
+	return &SymbolGraph{*searching.NewRedBlackBST(), nil, *NewGraphN(0)}
+}
 
-	// inverted index to get string keys in an array
-	keys := make([]string, st.Size())
-	for _, k := range st.Keys() {
-		i := st.Get(k).(int)
-		keys[i] = string(k.(searching.StringKey))
+// connects the first vertex on a line to all others
+func (sg *SymbolGraph) addLine(names []string) {
+	v := sg.AddVertex(names[0])
+	for _, name := range names[1:] {
+		sg.graph.AddEdge(v, sg.AddVertex(name))
 	}
+}
 
-	// second pass builds the graph by connecting first vertex
-	// on each line to all others
-	graph := NewGraphN(st.Size())
-	in = testutil.NewIn(filepath)
-	for in.HasNext() {
-		slice := strings.Split(in.ReadLine(), delimiter)
-		key := searching.StringKey(slice[0])
-		v := st.Get(key).(int) // first vertex
-		var w int
-		for _, str := range slice[1:] {
-			key = searching.StringKey(str)
-			w = st.Get(key).(int)
-			graph.AddEdge(v, w)
-		}
+// Adds a vertex named s, if there is none, and returns its index
+func (sg *SymbolGraph) AddVertex(s string) int {
+	if v, ok := sg.Lookup(s); ok {
+		return v
 	}
+	v := sg.graph.AddVertex()
+	sg.st.Put(searching.StringKey(s), v)
+	sg.keys = append(sg.keys, s)
+	return v
+}
 
-	return SymbolGraph{*st, keys, *graph}
+// Adds the edge between the vertices named v and w, and the vertices if
+// there are none
+func (sg *SymbolGraph) AddEdge(v, w string) {
+	sg.graph.AddEdge(sg.AddVertex(v), sg.AddVertex(w))
+}
+
+// Returns the integer associated with the vertex named s,
+// and false if there is no such vertex
+func (sg SymbolGraph) Lookup(s string) (int, bool) {
+	v := sg.st.Get(searching.StringKey(s))
+	if v == nil {
+		return -1, false
+	}
+	return v.(int), true
 }
 
 // Does the graph contain the vertex named s
@@ -80,7 +112,8 @@ func (sg SymbolGraph) Contains(s string) bool {
 	return sg.st.Contains(key)
 }
 
-// Returns the integer associated with the vertex named s
+// Returns the integer associated with the vertex named s.
+// Panics if there is no such vertex, see Lookup.
 func (sg SymbolGraph) Index(s string) int {
 	key := searching.StringKey(s)
 	return sg.st.Get(key).(int)
@@ -96,3 +129,12 @@ func (sg SymbolGraph) Name(v int) string {
 func (sg SymbolGraph) Graph() Graph {
 	return sg.graph
 }
+
+// Returns the names of the vertices, in the same order
+func (sg SymbolGraph) Names(vertices []int) []string {
+	names := make([]string, len(vertices))
+	for i, v := range vertices {
+		names[i] = sg.Name(v)
+	}
+	return names
+}
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadSymbolGraph_errors(t *testing.T) {
+	errRead := errors.New("read error")
+	if _, err := ReadSymbolGraph(iotest.ErrReader(errRead), " "); err != errRead {
+		t.Errorf("read error: got %v", err)
+	}
+	if _, err := ReadSymbolGraph(strings.NewReader("a b"), ""); err == nil {
+		t.Errorf("empty delimiter: no error")
+	}
+	if _, err := ReadSymbolGraphCSV(strings.NewReader("a,\"b\nc,d\n"), ','); err == nil {
+		t.Errorf("missing quote: no error")
+	}
+	if _, err := ReadSymbolGraphCSV(iotest.ErrReader(errRead), ','); !errors.Is(err, errRead) {
+		t.Errorf("CSV read error: got %v", err)
+	}
+}
+
+// the lines are numbered as the names first appear, and empty lines are
+// skipped
+func TestReadSymbolGraph(t *testing.T) {
+	sg, err := ReadSymbolGraphCSV(strings.NewReader("a,\"b,c\"\n\n\"b,c\",d,a\n"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sg.Names([]int{0, 1, 2}); strings.Join(got, "|") != "a|b,c|d" {
+		t.Errorf("got names %q", got)
+	}
+	g := sg.Graph()
+	if g.V() != 3 || g.E() != 3 || !g.HasEdge(0, 1) || !g.HasEdge(1, 2) {
+		t.Errorf("got graph %v", &g)
+	}
+}
@@ -0,0 +1,213 @@
+package graphio
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/mst"
+)
+
+// Graph formats.
+// Writers and readers for GraphViz DOT, JSON (node-link, as used by D3 and
+// NetworkX), GraphML and plain edge lists. All of them go through Data, a
+// list of edges that can be made from, and turned back into, a Graph, a
+// Digraph, an EdgeWeightedGraph or an EdgeWeightedDigraph. Besides the edges,
+// Data carries vertex labels (the names of a symbol graph), vertex groups
+// (connected or strong components, drawn in different colors) and highlighted
+// edges (a path, an MST or a shortest-paths tree, drawn in bold).
+
+type Data struct {
+	Directed bool
+	Weighted bool
+	V        int      // number of vertices
+	Edges    []Edge   // the edges, each undirected edge once
+	Labels   []string // Labels[v]: name of vertex v (or nil)
+	Groups   []int    // Groups[v]: group of vertex v (or nil)
+}
+
+type Edge struct {
+	V, W      int
+	Weight    float64
+	Highlight bool
+}
+
+// FromGraph returns the data of an undirected graph
+func FromGraph(g graph.IGraph) *Data {
+	d := &Data{V: g.V()}
+	for v := 0; v < g.V(); v++ {
+		selfLoops := 0
+		for _, ww := range g.Adj(v) {
+			w := ww.(int)
+			if w > v {
+				d.Edges = append(d.Edges, Edge{V: v, W: w})
+			} else if w == v {
+				// add only one copy of each self loop
+				if selfLoops%2 == 0 {
+					d.Edges = append(d.Edges, Edge{V: v, W: w})
+				}
+				selfLoops++
+			}
+		}
+	}
+	return d
+}
+
+// FromDigraph returns the data of a digraph
+func FromDigraph(g digraph.IDigraph) *Data {
+	d := &Data{Directed: true, V: g.V()}
+	for v := 0; v < g.V(); v++ {
+		for _, w := range g.Adj(v) {
+			d.Edges = append(d.Edges, Edge{V: v, W: w.(int)})
+		}
+	}
+	return d
+}
+
+// FromEdgeWeightedGraph returns the data of an edge-weighted graph
+func FromEdgeWeightedGraph(g *mst.EdgeWeightedGraph) *Data {
+	d := &Data{Weighted: true, V: g.V()}
+	for _, x := range g.Edges() {
+		e := x.(*mst.Edge)
+		v := e.Either()
+		d.Edges = append(d.Edges, Edge{V: v, W: e.Other(v), Weight: e.Weight()})
+	}
+	return d
+}
+
+// FromEdgeWeightedDigraph returns the data of an edge-weighted digraph
+func FromEdgeWeightedDigraph(g *digraph.EdgeWeightedDigraph) *Data {
+	d := &Data{Directed: true, Weighted: true, V: g.V()}
+	for _, x := range g.Edges() {
+		e := x.(*digraph.DirectedEdge)
+		d.Edges = append(d.Edges, Edge{V: e.From(), W: e.To(), Weight: e.Weight()})
+	}
+	return d
+}
+
+// Graph returns an undirected graph with the edges (ignoring the weights)
+func (d *Data) Graph() *graph.Graph {
+	g := graph.NewGraphN(d.V)
+	for _, e := range d.Edges {
+		g.AddEdge(e.V, e.W)
+	}
+	return g
+}
+
+// Digraph returns a digraph with the edges (ignoring the weights)
+func (d *Data) Digraph() *digraph.Digraph {
+	g := digraph.NewDigraphN(d.V)
+	for _, e := range d.Edges {
+		g.AddEdge(e.V, e.W)
+	}
+	return g
+}
+
+// EdgeWeightedGraph returns an edge-weighted graph with the edges
+func (d *Data) EdgeWeightedGraph() *mst.EdgeWeightedGraph {
+	g := mst.NewEdgeWeightedGraphN(d.V)
+	for _, e := range d.Edges {
+		g.AddEdge(mst.NewEdge(e.V, e.W, e.Weight))
+	}
+	return g
+}
+
+// EdgeWeightedDigraph returns an edge-weighted digraph with the edges
+func (d *Data) EdgeWeightedDigraph() *digraph.EdgeWeightedDigraph {
+	g := digraph.NewEdgeWeightedDigraphN(d.V)
+	for _, e := range d.Edges {
+		g.AddEdge(digraph.NewDirectedEdge(e.V, e.W, e.Weight))
+	}
+	return g
+}
+
+// SetLabels names the vertices, typically with the Name method of a
+// SymbolGraph or SymbolDigraph
+func (d *Data) SetLabels(name func(v int) string) {
+	d.Labels = make([]string, d.V)
+	for v := range d.Labels {
+		d.Labels[v] = name(v)
+	}
+}
+
+// SetGroups puts the vertices in groups, typically with the Id method of
+// ConnectedComponents or KosarajuSharirSCC
+func (d *Data) SetGroups(id func(v int) int) {
+	d.Groups = make([]int, d.V)
+	for v := range d.Groups {
+		d.Groups[v] = id(v)
+	}
+}
+
+// HighlightEdges highlights the edges, which may be *mst.Edge (the edges of
+// an MST), *digraph.DirectedEdge (a shortest path) or [2]int values.
+// Returns an error if an edge is not in the graph.
+func (d *Data) HighlightEdges(edges fund.Iterator) error {
+	for _, x := range edges {
+		var v, w int
+		weight, weighted := 0.0, d.Weighted
+		switch e := x.(type) {
+		case *mst.Edge:
+			v = e.Either()
+			w, weight = e.Other(v), e.Weight()
+		case *digraph.DirectedEdge:
+			v, w, weight = e.From(), e.To(), e.Weight()
+		case [2]int:
+			v, w = e[0], e[1]
+			weighted = false
+		default:
+			panic(fmt.Sprintf("can not highlight %T", x))
+		}
+
+		if err := d.highlight(v, w, weight, weighted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HighlightPath highlights the edges between consecutive vertices of path,
+// for instance a path found by BreadthFirstPaths or a cycle.
+// Returns an error if an edge is not in the graph.
+func (d *Data) HighlightPath(path []int) error {
+	for i := 1; i < len(path); i++ {
+		if err := d.highlight(path[i-1], path[i], 0, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// highlights an edge v-w (with the weight, if weighted) that is not
+// highlighted yet; if there is none, such an edge must already be highlighted
+func (d *Data) highlight(v, w int, weight float64, weighted bool) error {
+	found := -1
+	for i, e := range d.Edges {
+		match := e.V == v && e.W == w || !d.Directed && e.V == w && e.W == v
+		if !match || weighted && e.Weight != weight {
+			continue
+		}
+		found = i
+		if !e.Highlight {
+			break
+		}
+	}
+
+	if found == -1 {
+		if weighted {
+			return fmt.Errorf("no edge %d-%d %g in the graph", v, w, weight)
+		}
+		return fmt.Errorf("no edge %d-%d in the graph", v, w)
+	}
+	d.Edges[found].Highlight = true
+	return nil
+}
+
+// the label of vertex v
+func (d *Data) label(v int) string {
+	if d.Labels != nil {
+		return d.Labels[v]
+	}
+	return ""
+}
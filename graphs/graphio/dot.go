@@ -0,0 +1,414 @@
+package graphio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GraphViz DOT.
+// Vertices are written as their numbers, with their label (if any) as the
+// label attribute; edge weights are the labels of the edges. Groups are
+// filled with the colors of the set312 color scheme (and kept in the group
+// attribute), highlighted edges are drawn red and bold. Render with, e.g.,
+//   dot -Tsvg graph.dot > graph.svg
+//
+// The reader understands the statements the writer writes, and simple files
+// written by hand or other tools: node statements, edge statements (also
+// chains such as a -- b -- c), and attributes, which are ignored except for
+// label, weight, group and color. Subgraphs are not supported. If the node
+// ids are not all non-negative integers, the vertices are numbered in order
+// of appearance and labeled with their ids.
+
+const (
+	highlightColor = "red"
+	groupColors    = 12 // number of colors in the set312 scheme
+)
+
+// WriteDOT writes d in the DOT language
+func WriteDOT(w io.Writer, d *Data) error {
+	bw := bufio.NewWriter(w)
+
+	kind, op := "graph", "--"
+	if d.Directed {
+		kind, op = "digraph", "->"
+	}
+	fmt.Fprintf(bw, "%s {\n", kind)
+
+	for v := 0; v < d.V; v++ {
+		var attrs []string
+		if d.Labels != nil {
+			attrs = append(attrs, "label="+quote(d.Labels[v]))
+		}
+		if d.Groups != nil {
+			g := d.Groups[v]
+			attrs = append(attrs, fmt.Sprintf("group=%d, style=filled, colorscheme=set312, fillcolor=%d",
+				g, mod(g, groupColors)+1))
+		}
+		writeStatement(bw, strconv.Itoa(v), attrs)
+	}
+
+	for _, e := range d.Edges {
+		var attrs []string
+		if d.Weighted {
+			attrs = append(attrs, "label="+quote(formatWeight(e.Weight)))
+		}
+		if e.Highlight {
+			attrs = append(attrs, "color="+highlightColor+", penwidth=2")
+		}
+		writeStatement(bw, fmt.Sprintf("%d %s %d", e.V, op, e.W), attrs)
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func writeStatement(w io.Writer, s string, attrs []string) {
+	if len(attrs) == 0 {
+		fmt.Fprintf(w, "  %s;\n", s)
+	} else {
+		fmt.Fprintf(w, "  %s [%s];\n", s, strings.Join(attrs, ", "))
+	}
+}
+
+// quotes s as a DOT string
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+func mod(a, b int) int {
+	return (a%b + b) % b
+}
+
+// ReadDOT reads a graph in the DOT language
+func ReadDOT(r io.Reader) (*Data, error) {
+	p, err := newDOTParser(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.data()
+}
+
+type dotEdge struct {
+	v, w  string
+	attrs map[string]string
+}
+
+type dotParser struct {
+	tokens    []dotToken
+	pos       int
+	directed  bool
+	names     *nameIndex
+	nodeAttrs map[string]map[string]string // node id -> attributes
+	edges     []dotEdge
+}
+
+type dotToken struct {
+	text   string
+	quoted bool // a quoted string, never a keyword or punctuation
+}
+
+func newDOTParser(r io.Reader) (*dotParser, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := dotTokens(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dotParser{
+		tokens:    tokens,
+		names:     newNameIndex(),
+		nodeAttrs: make(map[string]map[string]string),
+	}, nil
+}
+
+// splits the source into identifiers, strings and punctuation,
+// skipping comments
+func dotTokens(src string) ([]dotToken, error) {
+	var tokens []dotToken
+	rs := []rune(src)
+
+	for i := 0; i < len(rs); {
+		c := rs[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '#' && (i == 0 || rs[i-1] == '\n'), c == '/' && i+1 < len(rs) && rs[i+1] == '/':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			j := i + 2
+			for j+1 < len(rs) && !(rs[j] == '*' && rs[j+1] == '/') {
+				j++
+			}
+			if j+1 >= len(rs) {
+				return nil, errors.New("unterminated comment")
+			}
+			i = j + 2
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for ; i < len(rs) && rs[i] != '"'; i++ {
+				if rs[i] == '\\' && i+1 < len(rs) && (rs[i+1] == '"' || rs[i+1] == '\\') {
+					i++
+				}
+				sb.WriteRune(rs[i])
+			}
+			if i == len(rs) {
+				return nil, errors.New("unterminated string")
+			}
+			i++
+			tokens = append(tokens, dotToken{sb.String(), true})
+		case c == '-' && i+1 < len(rs) && (rs[i+1] == '-' || rs[i+1] == '>'):
+			tokens = append(tokens, dotToken{string(rs[i : i+2]), false})
+			i += 2
+		case strings.ContainsRune("{}[]=;,:", c):
+			tokens = append(tokens, dotToken{string(c), false})
+			i++
+		case c == '<':
+			return nil, errors.New("HTML strings are not supported")
+		case c == '_' || c == '.' || c == '-' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(rs) && (rs[j] == '_' || rs[j] == '.' || unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j])) {
+				j++
+			}
+			tokens = append(tokens, dotToken{string(rs[i:j]), false})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *dotParser) peek() (dotToken, bool) {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos], true
+	}
+	return dotToken{}, false
+}
+
+func (p *dotParser) next() (dotToken, error) {
+	t, ok := p.peek()
+	if !ok {
+		return t, io.ErrUnexpectedEOF
+	}
+	p.pos++
+	return t, nil
+}
+
+// is the next token the (unquoted) keyword or punctuation s?
+func (p *dotParser) at(s string) bool {
+	t, ok := p.peek()
+	return ok && !t.quoted && strings.EqualFold(t.text, s)
+}
+
+func (p *dotParser) expect(s string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.quoted || !strings.EqualFold(t.text, s) {
+		return fmt.Errorf("expected %q, found %q", s, t.text)
+	}
+	return nil
+}
+
+func (p *dotParser) id() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if !t.quoted && strings.ContainsAny(t.text, "{}[]=;,:") || !t.quoted && (t.text == "--" || t.text == "->") {
+		return "", fmt.Errorf("expected an id, found %q", t.text)
+	}
+	return t.text, nil
+}
+
+// graph : [ strict ] (graph | digraph) [ ID ] '{' stmt_list '}'
+func (p *dotParser) parse() error {
+	if p.at("strict") {
+		p.pos++
+	}
+	switch {
+	case p.at("graph"):
+	case p.at("digraph"):
+		p.directed = true
+	default:
+		return errors.New("expected graph or digraph")
+	}
+	p.pos++
+	if !p.at("{") {
+		if _, err := p.id(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for !p.at("}") {
+		if err := p.statement(); err != nil {
+			return err
+		}
+		if p.at(";") || p.at(",") {
+			p.pos++
+		}
+	}
+	p.pos++
+
+	if p.pos != len(p.tokens) {
+		return errors.New("unexpected text after the graph")
+	}
+	return nil
+}
+
+func (p *dotParser) statement() error {
+	switch {
+	case p.at("graph"), p.at("node"), p.at("edge"):
+		// default attributes
+		p.pos++
+		_, err := p.attrs()
+		return err
+	case p.at("subgraph"), p.at("{"):
+		return errors.New("subgraphs are not supported")
+	}
+
+	id, err := p.id()
+	if err != nil {
+		return err
+	}
+	if p.at("=") {
+		// graph attribute
+		p.pos++
+		_, err := p.id()
+		return err
+	}
+	if p.at(":") {
+		return errors.New("ports are not supported")
+	}
+
+	ids := []string{id}
+	edgeOp := "--"
+	if p.directed {
+		edgeOp = "->"
+	}
+	for p.at("--") || p.at("->") {
+		if !p.at(edgeOp) {
+			return fmt.Errorf("edge operator %q does not match the graph kind", p.tokens[p.pos].text)
+		}
+		p.pos++
+		w, err := p.id()
+		if err != nil {
+			return err
+		}
+		ids = append(ids, w)
+	}
+
+	attrs, err := p.attrs()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		p.names.add(id)
+	}
+	if len(ids) == 1 {
+		if p.nodeAttrs[id] == nil {
+			p.nodeAttrs[id] = make(map[string]string)
+		}
+		for k, v := range attrs {
+			p.nodeAttrs[id][k] = v
+		}
+		return nil
+	}
+	for i := 1; i < len(ids); i++ {
+		p.edges = append(p.edges, dotEdge{ids[i-1], ids[i], attrs})
+	}
+	return nil
+}
+
+// attr_list : '[' [ a_list ] ']' [ attr_list ]
+func (p *dotParser) attrs() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for p.at("[") {
+		p.pos++
+		for !p.at("]") {
+			key, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			value, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			attrs[key] = value
+			if p.at(",") || p.at(";") {
+				p.pos++
+			}
+		}
+		p.pos++
+	}
+	return attrs, nil
+}
+
+func (p *dotParser) data() (*Data, error) {
+	d := p.names.data()
+	d.Directed = p.directed
+
+	for _, name := range p.names.names {
+		v := p.names.index(name)
+		attrs := p.nodeAttrs[name]
+		if label, ok := attrs["label"]; ok {
+			if d.Labels == nil {
+				d.Labels = make([]string, d.V)
+			}
+			d.Labels[v] = label
+		}
+		if group, ok := attrs["group"]; ok {
+			g, err := strconv.Atoi(group)
+			if err != nil {
+				return nil, fmt.Errorf("node %s: invalid group %q", name, group)
+			}
+			if d.Groups == nil {
+				d.Groups = make([]int, d.V)
+			}
+			d.Groups[v] = g
+		}
+	}
+
+	for _, de := range p.edges {
+		e := Edge{V: p.names.index(de.v), W: p.names.index(de.w)}
+		weight, ok := de.attrs["weight"]
+		if !ok {
+			weight, ok = de.attrs["label"]
+		}
+		if ok {
+			if x, err := strconv.ParseFloat(weight, 64); err == nil {
+				e.Weight = x
+				d.Weighted = true
+			}
+		}
+		e.Highlight = de.attrs["color"] == highlightColor
+		d.Edges = append(d.Edges, e)
+	}
+
+	return d, nil
+}
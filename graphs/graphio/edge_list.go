@@ -0,0 +1,176 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Edge lists.
+// One edge per line: the two vertices, followed by the weight if the graph
+// is weighted. Vertices are written by label if the graph has labels, which
+// then must not contain white space. A line with a single vertex declares a
+// vertex: the writer declares the vertices without edges, so that they are
+// not lost, or all the vertices in order if they have labels, so that they
+// keep their numbers. Blank lines and lines starting with # are ignored.
+
+// WriteEdgeList writes d as an edge list
+func WriteEdgeList(w io.Writer, d *Data) error {
+	bw := bufio.NewWriter(w)
+
+	name := strconv.Itoa
+	if d.Labels != nil {
+		name = d.label
+	}
+
+	degree := make([]int, d.V)
+	for _, e := range d.Edges {
+		degree[e.V]++
+		degree[e.W]++
+	}
+	for v := 0; v < d.V; v++ {
+		if degree[v] == 0 || d.Labels != nil {
+			fmt.Fprintln(bw, name(v))
+		}
+	}
+
+	for _, e := range d.Edges {
+		if d.Weighted {
+			fmt.Fprintln(bw, name(e.V), name(e.W), formatWeight(e.Weight))
+		} else {
+			fmt.Fprintln(bw, name(e.V), name(e.W))
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ReadEdgeList reads an edge list. If every vertex is a non-negative integer,
+// and the integers are not much sparser than 0 to V-1, the graph has vertices
+// 0 to the largest one; otherwise the vertices are numbered in order of
+// appearance and labeled with their names. The graph is weighted if the edges
+// have a third column, which all of them must have then.
+func ReadEdgeList(r io.Reader, directed bool) (*Data, error) {
+	type line struct {
+		names  []string
+		weight float64
+	}
+	var lines []line
+	weighted := false
+	columns, first := 0, 0 // number of fields of the first edge, and its line
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		l := line{}
+		switch len(fields) {
+		case 1, 2:
+			l.names = fields
+		case 3:
+			weight, err := strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid weight %q", n, fields[2])
+			}
+			l.names, l.weight = fields[:2], weight
+			weighted = true
+		default:
+			return nil, fmt.Errorf("line %d: too many fields", n)
+		}
+		if len(fields) > 1 {
+			if columns == 0 {
+				columns, first = len(fields), n
+			} else if len(fields) != columns {
+				return nil, fmt.Errorf("line %d: %d fields, but %d on line %d", n, len(fields), columns, first)
+			}
+		}
+		lines = append(lines, l)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	names := newNameIndex()
+	for _, l := range lines {
+		for _, s := range l.names {
+			names.add(s)
+		}
+	}
+
+	d := names.data()
+	d.Directed, d.Weighted = directed, weighted
+	for _, l := range lines {
+		if len(l.names) == 2 {
+			v, w := names.index(l.names[0]), names.index(l.names[1])
+			d.Edges = append(d.Edges, Edge{V: v, W: w, Weight: l.weight})
+		}
+	}
+
+	return d, nil
+}
+
+// nameIndex numbers the vertices of a file: by value if all the names are
+// non-negative integers, in order of appearance otherwise. Integers that are
+// too sparse (a file with the two vertices 0 and 9999999999) are taken as
+// names: the vertices would be mostly unused, and could not be allocated.
+type nameIndex struct {
+	names   []string       // names in order of appearance
+	indices map[string]int // name -> order of appearance
+	numeric bool           // are all names non-negative integers?
+	max     int            // largest integer name
+}
+
+func newNameIndex() *nameIndex {
+	return &nameIndex{indices: make(map[string]int), numeric: true, max: -1}
+}
+
+func (ni *nameIndex) add(s string) {
+	if _, ok := ni.indices[s]; ok {
+		return
+	}
+	ni.indices[s] = len(ni.names)
+	ni.names = append(ni.names, s)
+
+	if i, err := strconv.Atoi(s); err == nil && i >= 0 && strconv.Itoa(i) == s {
+		if i > ni.max {
+			ni.max = i
+		}
+	} else {
+		ni.numeric = false
+	}
+}
+
+// the largest integer name, as a factor of the number of names, up to which
+// the names are the vertices
+const maxSparsity = 2
+
+// Are the names taken as vertices?
+func (ni *nameIndex) byValue() bool {
+	return ni.numeric && ni.max < maxSparsity*len(ni.names)
+}
+
+func (ni *nameIndex) index(s string) int {
+	if ni.byValue() {
+		i, _ := strconv.Atoi(s)
+		return i
+	}
+	return ni.indices[s]
+}
+
+// returns the data with the vertices, and their labels if the names
+// are not the vertices
+func (ni *nameIndex) data() *Data {
+	if ni.byValue() {
+		return &Data{V: ni.max + 1}
+	}
+	return &Data{V: len(ni.names), Labels: ni.names}
+}
+
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'g', -1, 64)
+}
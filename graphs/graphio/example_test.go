@@ -0,0 +1,226 @@
+package graphio_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/graphio"
+	"github.com/youngzhu/algs4-go/graphs/mst"
+	"github.com/youngzhu/algs4-go/graphs/sp"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// a shortest path in a symbol graph, with the names as labels
+func ExampleWriteDOT() {
+	sg := graph.NewSymbolGraph("testdata/routes.txt", " ")
+	g := sg.Graph()
+	bfs := graph.NewBreadthFirstPaths(g, sg.Index("JFK"))
+
+	d := graphio.FromGraph(&g)
+	d.SetLabels(sg.Name)
+	if err := d.HighlightPath(bfs.PathTo(sg.Index("LAS"))); err != nil {
+		fmt.Println(err)
+	}
+
+	graphio.WriteDOT(os.Stdout, d)
+
+	// Output:
+	// graph {
+	//   0 [label="JFK"];
+	//   1 [label="MCO"];
+	//   2 [label="ORD"];
+	//   3 [label="DEN"];
+	//   4 [label="HOU"];
+	//   5 [label="DFW"];
+	//   6 [label="PHX"];
+	//   7 [label="ATL"];
+	//   8 [label="LAX"];
+	//   9 [label="LAS"];
+	//   0 -- 2 [color=red, penwidth=2];
+	//   0 -- 7;
+	//   0 -- 1;
+	//   1 -- 4;
+	//   1 -- 7;
+	//   2 -- 7;
+	//   2 -- 6 [color=red, penwidth=2];
+	//   2 -- 5;
+	//   2 -- 4;
+	//   2 -- 3;
+	//   3 -- 9;
+	//   3 -- 6;
+	//   4 -- 5;
+	//   4 -- 7;
+	//   5 -- 6;
+	//   6 -- 9 [color=red, penwidth=2];
+	//   6 -- 8;
+	//   8 -- 9;
+	// }
+}
+
+// strong components in colors
+func ExampleWriteDOT_scc() {
+	g := digraph.NewDigraphN(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 2)
+
+	scc := digraph.NewKosarajuSharirSCC(*g)
+	d := graphio.FromDigraph(g)
+	d.SetGroups(scc.Id)
+
+	graphio.WriteDOT(os.Stdout, d)
+
+	// Output:
+	// digraph {
+	//   0 [group=1, style=filled, colorscheme=set312, fillcolor=2];
+	//   1 [group=1, style=filled, colorscheme=set312, fillcolor=2];
+	//   2 [group=0, style=filled, colorscheme=set312, fillcolor=1];
+	//   3 [group=0, style=filled, colorscheme=set312, fillcolor=1];
+	//   4 [group=0, style=filled, colorscheme=set312, fillcolor=1];
+	//   0 -> 1;
+	//   1 -> 2;
+	//   1 -> 0;
+	//   2 -> 3;
+	//   3 -> 4;
+	//   4 -> 2;
+	// }
+}
+
+// a minimum spanning tree
+func ExampleWriteJSON() {
+	g := mst.NewEdgeWeightedGraphN(3)
+	g.AddEdge(mst.NewEdge(0, 1, 0.5))
+	g.AddEdge(mst.NewEdge(1, 2, 0.25))
+	g.AddEdge(mst.NewEdge(2, 0, 1))
+
+	d := graphio.FromEdgeWeightedGraph(g)
+	d.HighlightEdges(mst.NewKruskalMST(*g).Edges())
+
+	graphio.WriteJSON(os.Stdout, d)
+
+	// Output:
+	// {
+	//   "directed": false,
+	//   "multigraph": true,
+	//   "nodes": [
+	//     {
+	//       "id": 0
+	//     },
+	//     {
+	//       "id": 1
+	//     },
+	//     {
+	//       "id": 2
+	//     }
+	//   ],
+	//   "links": [
+	//     {
+	//       "source": 1,
+	//       "target": 2,
+	//       "weight": 0.25,
+	//       "highlight": true
+	//     },
+	//     {
+	//       "source": 0,
+	//       "target": 1,
+	//       "weight": 0.5,
+	//       "highlight": true
+	//     },
+	//     {
+	//       "source": 2,
+	//       "target": 0,
+	//       "weight": 1
+	//     }
+	//   ]
+	// }
+}
+
+// a shortest path
+func ExampleWriteGraphML() {
+	g := digraph.NewEdgeWeightedDigraphN(3)
+	g.AddEdge(digraph.NewDirectedEdge(0, 1, 0.5))
+	g.AddEdge(digraph.NewDirectedEdge(1, 2, 0.25))
+	g.AddEdge(digraph.NewDirectedEdge(0, 2, 1))
+
+	d := graphio.FromEdgeWeightedDigraph(g)
+	dijkstra := sp.NewDijkstraSP(*g, 0)
+	d.HighlightEdges(dijkstra.PathTo(2))
+
+	graphio.WriteGraphML(os.Stdout, d)
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	//   <key id="weight" for="edge" attr.name="weight" attr.type="double"></key>
+	//   <key id="highlight" for="edge" attr.name="highlight" attr.type="boolean"></key>
+	//   <graph id="G" edgedefault="directed">
+	//     <node id="n0"></node>
+	//     <node id="n1"></node>
+	//     <node id="n2"></node>
+	//     <edge source="n1" target="n2">
+	//       <data key="weight">0.25</data>
+	//       <data key="highlight">true</data>
+	//     </edge>
+	//     <edge source="n0" target="n1">
+	//       <data key="weight">0.5</data>
+	//       <data key="highlight">true</data>
+	//     </edge>
+	//     <edge source="n0" target="n2">
+	//       <data key="weight">1</data>
+	//     </edge>
+	//   </graph>
+	// </graphml>
+}
+
+func ExampleWriteEdgeList() {
+	in := testutil.NewInReadWords("testdata/tinyEWG.txt")
+	g := mst.NewEdgeWeightedGraphIn(in)
+
+	var sb strings.Builder
+	graphio.WriteEdgeList(&sb, graphio.FromEdgeWeightedGraph(g))
+	d, err := graphio.ReadEdgeList(strings.NewReader(sb.String()), false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(d.V, len(d.Edges), d.Weighted)
+	fmt.Printf("%.5f\n", mst.NewPrimMST(*d.EdgeWeightedGraph()).Weight())
+
+	// Output:
+	// 8 16 true
+	// 1.81000
+}
+
+func ExampleReadDOT() {
+	src := `
+	// a cycle and an isolated vertex
+	digraph cycle {
+		node [shape=box];
+		a -> b -> c -> a [label="1.5"];
+		d;
+	}`
+
+	d, err := graphio.ReadDOT(strings.NewReader(src))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(d.Labels, d.Weighted)
+	fmt.Print(d.Digraph())
+
+	// Output:
+	// [a b c d] true
+	// 4 vertices, 3 edges
+	// 0: 1
+	// 1: 2
+	// 2: 0
+	// 3:
+}
@@ -0,0 +1,166 @@
+package graphio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/mst"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+type format struct {
+	name  string
+	write func(w io.Writer, d *Data) error
+	read  func(r io.Reader, d *Data) (*Data, error)
+}
+
+var formats = []format{
+	{"DOT", WriteDOT, func(r io.Reader, _ *Data) (*Data, error) { return ReadDOT(r) }},
+	{"JSON", WriteJSON, func(r io.Reader, _ *Data) (*Data, error) { return ReadJSON(r) }},
+	{"GraphML", WriteGraphML, func(r io.Reader, _ *Data) (*Data, error) { return ReadGraphML(r) }},
+	{"edge list", WriteEdgeList, func(r io.Reader, d *Data) (*Data, error) { return ReadEdgeList(r, d.Directed) }},
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	gg := graph.NewGraphGenerator(r)
+	dg := digraph.NewDigraphGenerator(r)
+	wgg := mst.NewEdgeWeightedGraphGenerator(r)
+	wdg := digraph.NewEdgeWeightedDigraphGenerator(r)
+
+	g := gg.EulerianCycle(20, 60) // self-loops and parallel edges
+	withLabels := FromGraph(g)
+	withLabels.SetLabels(func(v int) string { return fmt.Sprintf("v%02d", v) })
+	withGroups := FromDigraph(dg.Strong(30, 100, 4))
+	scc := digraph.NewKosarajuSharirSCC(*withGroups.Digraph())
+	withGroups.SetGroups(scc.Id)
+	ewg := wgg.Weighted(gg.Simple(25, 80))
+	withMST := FromEdgeWeightedGraph(ewg)
+	if err := withMST.HighlightEdges(mst.NewPrimMST(*ewg).Edges()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]*Data{
+		"graph":                 FromGraph(g),
+		"labeled graph":         withLabels,
+		"digraph":               FromDigraph(dg.Simple(20, 50)),
+		"grouped digraph":       withGroups,
+		"edge-weighted graph":   withMST,
+		"edge-weighted digraph": FromEdgeWeightedDigraph(wdg.Random(20, 60)),
+		"isolated vertices":     FromGraph(graph.NewGraphN(3)),
+	}
+
+	for name, d := range tests {
+		for _, f := range formats {
+			var buf bytes.Buffer
+			if err := f.write(&buf, d); err != nil {
+				t.Fatalf("%s, %s: %v", name, f.name, err)
+			}
+			got, err := f.read(&buf, d)
+			if err != nil {
+				t.Fatalf("%s, %s: %v", name, f.name, err)
+			}
+
+			want := *d
+			if f.name == "edge list" {
+				// no groups or highlights in edge lists
+				want.Groups = nil
+				want.Edges = nil
+				for _, e := range d.Edges {
+					e.Highlight = false
+					want.Edges = append(want.Edges, e)
+				}
+			}
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("%s, %s: got %+v, want %+v", name, f.name, *got, want)
+			}
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	g := graph.NewGraphN(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(1, 2)
+	d := FromGraph(g)
+
+	if err := d.HighlightPath([]int{0, 1, 0, 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !d.Edges[0].Highlight || !d.Edges[1].Highlight || d.Edges[2].Highlight {
+		t.Errorf("parallel edges: %+v", d.Edges)
+	}
+	if err := d.HighlightPath([]int{0, 2}); err == nil {
+		t.Error("no error for an edge not in the graph")
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		read func(r io.Reader) (*Data, error)
+		src  string
+	}{
+		{"DOT subgraph", ReadDOT, "graph { subgraph { a -- b } }"},
+		{"DOT edge kind", ReadDOT, "graph { a -> b }"},
+		{"DOT unterminated", ReadDOT, `graph { a [label="x] }`},
+		{"DOT no graph", ReadDOT, "a -- b"},
+		{"JSON unknown node", ReadJSON, `{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 1}]}`},
+		{"JSON duplicate node", ReadJSON, `{"nodes": [{"id": "a"}, {"id": "a"}], "links": []}`},
+		{"JSON node id", ReadJSON, `{"nodes": [{"id": [0]}], "links": []}`},
+		{"GraphML unknown node", ReadGraphML, `<graphml><graph edgedefault="directed"><node id="a"/><edge source="a" target="b"/></graph></graphml>`},
+		{"edge list weight", func(r io.Reader) (*Data, error) { return ReadEdgeList(r, false) }, "0 1 x"},
+		{"edge list columns", func(r io.Reader) (*Data, error) { return ReadEdgeList(r, false) }, "0 1 2.5\n3\n1 2"},
+	}
+
+	for _, tt := range tests {
+		if _, err := tt.read(strings.NewReader(tt.src)); err == nil {
+			t.Errorf("%s: no error", tt.name)
+		}
+	}
+}
+
+func TestReadEdgeList_sparse(t *testing.T) {
+	// dense numbers are the vertices
+	d, err := ReadEdgeList(strings.NewReader("0 2\n1 3\n"), false)
+	if err != nil || d.V != 4 || d.Labels != nil || d.Edges[1] != (Edge{V: 1, W: 3}) {
+		t.Errorf("got %+v, %v", d, err)
+	}
+
+	// sparse numbers are names, in order of appearance
+	d, err = ReadEdgeList(strings.NewReader("0 9999999999\n9999999999 7\n"), true)
+	if err != nil || d.V != 3 || d.Edges[1] != (Edge{V: 1, W: 2}) {
+		t.Fatalf("got %+v, %v", d, err)
+	}
+	if want := []string{"0", "9999999999", "7"}; strings.Join(d.Labels, " ") != strings.Join(want, " ") {
+		t.Errorf("got labels %v, want %v", d.Labels, want)
+	}
+}
+
+// string ids, as in the D3 examples, are the labels
+func TestReadJSON_stringIDs(t *testing.T) {
+	src := `{"directed": true, "nodes": [{"id": "Myriel", "group": 1}, {"id": "Napoleon", "group": 1},
+		{"id": 7, "label": "Cravatte"}], "links": [{"source": "Napoleon", "target": "Myriel"},
+		{"source": "Myriel", "target": 7, "weight": 2}]}`
+	d, err := ReadJSON(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.V != 3 || !d.Directed || !d.Weighted || d.Groups[1] != 1 {
+		t.Errorf("got %+v", d)
+	}
+	if want := []string{"Myriel", "Napoleon", "Cravatte"}; strings.Join(d.Labels, " ") != strings.Join(want, " ") {
+		t.Errorf("got labels %v, want %v", d.Labels, want)
+	}
+	if d.Edges[0] != (Edge{V: 1, W: 0}) || d.Edges[1] != (Edge{V: 0, W: 2, Weight: 2}) {
+		t.Errorf("got edges %v", d.Edges)
+	}
+}
@@ -0,0 +1,195 @@
+package graphio
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GraphML, the XML format of yEd, Gephi and Cytoscape. The vertex labels and
+// groups and the edge weights and highlights are GraphML attributes named
+// label, group, weight and highlight. When reading, attributes are found by
+// name, whatever the id of their key.
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed string        `xml:"directed,attr,omitempty"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes d in GraphML
+func WriteGraphML(w io.Writer, d *Data) error {
+	gm := graphML{XMLNS: graphMLNamespace}
+	gm.Graph.ID = "G"
+	gm.Graph.EdgeDefault = "undirected"
+	if d.Directed {
+		gm.Graph.EdgeDefault = "directed"
+	}
+
+	if d.Labels != nil {
+		gm.Keys = append(gm.Keys, graphMLKey{"label", "node", "label", "string"})
+	}
+	if d.Groups != nil {
+		gm.Keys = append(gm.Keys, graphMLKey{"group", "node", "group", "int"})
+	}
+	if d.Weighted {
+		gm.Keys = append(gm.Keys, graphMLKey{"weight", "edge", "weight", "double"})
+	}
+	highlight := false
+	for _, e := range d.Edges {
+		highlight = highlight || e.Highlight
+	}
+	if highlight {
+		gm.Keys = append(gm.Keys, graphMLKey{"highlight", "edge", "highlight", "boolean"})
+	}
+
+	for v := 0; v < d.V; v++ {
+		n := graphMLNode{ID: "n" + strconv.Itoa(v)}
+		if d.Labels != nil {
+			n.Data = append(n.Data, graphMLData{"label", d.Labels[v]})
+		}
+		if d.Groups != nil {
+			n.Data = append(n.Data, graphMLData{"group", strconv.Itoa(d.Groups[v])})
+		}
+		gm.Graph.Nodes = append(gm.Graph.Nodes, n)
+	}
+	for _, e := range d.Edges {
+		ge := graphMLEdge{Source: "n" + strconv.Itoa(e.V), Target: "n" + strconv.Itoa(e.W)}
+		if d.Weighted {
+			ge.Data = append(ge.Data, graphMLData{"weight", formatWeight(e.Weight)})
+		}
+		if e.Highlight {
+			ge.Data = append(ge.Data, graphMLData{"highlight", "true"})
+		}
+		gm.Graph.Edges = append(gm.Graph.Edges, ge)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(gm); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML reads a graph in GraphML. The vertices are numbered in the
+// order of the nodes. Edges with a directed attribute different from the
+// default of the graph are not supported.
+func ReadGraphML(r io.Reader) (*Data, error) {
+	var gm graphML
+	if err := xml.NewDecoder(r).Decode(&gm); err != nil {
+		return nil, err
+	}
+
+	attr := make(map[string]string) // key id -> attribute name
+	for _, k := range gm.Keys {
+		attr[k.ID] = k.Name
+	}
+
+	d := &Data{V: len(gm.Graph.Nodes)}
+	switch gm.Graph.EdgeDefault {
+	case "directed":
+		d.Directed = true
+	case "undirected", "":
+	default:
+		return nil, fmt.Errorf("invalid edgedefault %q", gm.Graph.EdgeDefault)
+	}
+
+	index := make(map[string]int, d.V) // node id -> vertex
+	for v, n := range gm.Graph.Nodes {
+		if _, ok := index[n.ID]; ok {
+			return nil, fmt.Errorf("duplicate node id %q", n.ID)
+		}
+		index[n.ID] = v
+
+		for _, data := range n.Data {
+			switch attr[data.Key] {
+			case "label":
+				if d.Labels == nil {
+					d.Labels = make([]string, d.V)
+				}
+				d.Labels[v] = data.Value
+			case "group":
+				group, err := strconv.Atoi(data.Value)
+				if err != nil {
+					return nil, fmt.Errorf("node %q: invalid group %q", n.ID, data.Value)
+				}
+				if d.Groups == nil {
+					d.Groups = make([]int, d.V)
+				}
+				d.Groups[v] = group
+			}
+		}
+	}
+
+	for _, ge := range gm.Graph.Edges {
+		if ge.Directed != "" && (ge.Directed == "true") != d.Directed {
+			return nil, fmt.Errorf("edge %s-%s: mixed graphs are not supported", ge.Source, ge.Target)
+		}
+		v, ok := index[ge.Source]
+		if !ok {
+			return nil, fmt.Errorf("unknown source node %q", ge.Source)
+		}
+		w, ok := index[ge.Target]
+		if !ok {
+			return nil, fmt.Errorf("unknown target node %q", ge.Target)
+		}
+
+		e := Edge{V: v, W: w}
+		for _, data := range ge.Data {
+			switch attr[data.Key] {
+			case "weight":
+				weight, err := strconv.ParseFloat(data.Value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("edge %s-%s: invalid weight %q", ge.Source, ge.Target, data.Value)
+				}
+				e.Weight = weight
+				d.Weighted = true
+			case "highlight":
+				e.Highlight = data.Value == "true"
+			}
+		}
+		d.Edges = append(d.Edges, e)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,159 @@
+package graphio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// JSON node-link format, as read by D3 and NetworkX:
+//   {"directed": false, "multigraph": true,
+//    "nodes": [{"id": 0, "label": "JFK"}, ...],
+//    "links": [{"source": 0, "target": 1, "weight": 0.5}, ...]}
+// The node ids are numbers or strings, as in {"id": "Myriel"}.
+// Vertex groups are the "group" of the nodes, highlighted edges have
+// "highlight": true.
+
+type jsonGraph struct {
+	Directed   bool       `json:"directed"`
+	Multigraph bool       `json:"multigraph"`
+	Nodes      []jsonNode `json:"nodes"`
+	Links      []jsonLink `json:"links"`
+}
+
+type jsonNode struct {
+	ID    json.RawMessage `json:"id"`
+	Label string          `json:"label,omitempty"`
+	Group *int            `json:"group,omitempty"`
+}
+
+type jsonLink struct {
+	Source    json.RawMessage `json:"source"`
+	Target    json.RawMessage `json:"target"`
+	Weight    *float64        `json:"weight,omitempty"`
+	Highlight bool            `json:"highlight,omitempty"`
+}
+
+// WriteJSON writes d in JSON node-link format
+func WriteJSON(w io.Writer, d *Data) error {
+	jg := jsonGraph{
+		Directed:   d.Directed,
+		Multigraph: true,
+		Nodes:      make([]jsonNode, d.V),
+		Links:      make([]jsonLink, len(d.Edges)),
+	}
+
+	for v := range jg.Nodes {
+		jg.Nodes[v] = jsonNode{ID: jsonID(v), Label: d.label(v)}
+		if d.Groups != nil {
+			jg.Nodes[v].Group = &d.Groups[v]
+		}
+	}
+	for i, e := range d.Edges {
+		jg.Links[i] = jsonLink{Source: jsonID(e.V), Target: jsonID(e.W), Highlight: e.Highlight}
+		if d.Weighted {
+			weight := e.Weight
+			jg.Links[i].Weight = &weight
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jg)
+}
+
+func jsonID(v int) json.RawMessage {
+	return json.RawMessage(strconv.Itoa(v))
+}
+
+// the name of a node id, a number or a string
+func jsonName(id json.RawMessage) (string, error) {
+	var x interface{}
+	if err := json.Unmarshal(id, &x); err != nil {
+		return "", err
+	}
+	switch x := x.(type) {
+	case string:
+		return x, nil
+	case float64:
+		return string(id), nil
+	}
+	return "", fmt.Errorf("invalid node id %s", id)
+}
+
+// ReadJSON reads a graph in JSON node-link format. The node ids are
+// numbered like the vertices of an edge list: by value if they are
+// integers 0 to about V, in the order of the nodes otherwise, with the ids
+// as labels. The label of a node replaces its id. The graph is weighted if
+// any link has a weight.
+func ReadJSON(r io.Reader) (*Data, error) {
+	var jg jsonGraph
+	if err := json.NewDecoder(r).Decode(&jg); err != nil {
+		return nil, err
+	}
+
+	names := newNameIndex()
+	nodes := make([]string, len(jg.Nodes))
+	for i, n := range jg.Nodes {
+		name, err := jsonName(n.ID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := names.indices[name]; ok {
+			return nil, fmt.Errorf("duplicate node id %s", n.ID)
+		}
+		names.add(name)
+		nodes[i] = name
+	}
+
+	d := names.data()
+	d.Directed = jg.Directed
+	for i, n := range jg.Nodes {
+		v := names.index(nodes[i])
+		if n.Label != "" {
+			if d.Labels == nil {
+				d.Labels = make([]string, d.V)
+			}
+			d.Labels[v] = n.Label
+		}
+		if n.Group != nil {
+			if d.Groups == nil {
+				d.Groups = make([]int, d.V)
+			}
+			d.Groups[v] = *n.Group
+		}
+	}
+
+	for _, l := range jg.Links {
+		v, err := jsonVertex(names, l.Source)
+		if err != nil {
+			return nil, fmt.Errorf("source: %v", err)
+		}
+		w, err := jsonVertex(names, l.Target)
+		if err != nil {
+			return nil, fmt.Errorf("target: %v", err)
+		}
+
+		e := Edge{V: v, W: w, Highlight: l.Highlight}
+		if l.Weight != nil {
+			d.Weighted = true
+			e.Weight = *l.Weight
+		}
+		d.Edges = append(d.Edges, e)
+	}
+
+	return d, nil
+}
+
+// the vertex of a node id of a link
+func jsonVertex(names *nameIndex, id json.RawMessage) (int, error) {
+	name, err := jsonName(id)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := names.indices[name]; !ok {
+		return 0, fmt.Errorf("unknown node %s", id)
+	}
+	return names.index(name), nil
+}
@@ -0,0 +1,81 @@
+package iso_test
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/iso"
+)
+
+func newGraph(v int, edges ...[2]int) *graph.Graph {
+	g := graph.NewGraphN(v)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func newDigraph(v int, edges ...[2]int) *digraph.Digraph {
+	g := digraph.NewDigraphN(v)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func ExampleWeisfeilerLehmanHash() {
+	// a path 0-1-2-3, the same path from 2, and a star
+	path := newGraph(4, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3})
+	same := newGraph(4, [2]int{2, 0}, [2]int{0, 3}, [2]int{3, 1})
+	star := newGraph(4, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3})
+
+	fmt.Println(iso.WeisfeilerLehmanHash(path, 3) == iso.WeisfeilerLehmanHash(same, 3))
+	fmt.Println(iso.WeisfeilerLehmanHash(path, 3) == iso.WeisfeilerLehmanHash(star, 3))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleNewMatcher() {
+	// a square 0-1-2-3 with the diagonal 0-2
+	g := newGraph(4, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 0}, [2]int{0, 2})
+	// the same, with the diagonal 1-3
+	h := newGraph(4, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 0}, [2]int{1, 3})
+	fmt.Println(iso.NewMatcher(g, h).Isomorphism())
+
+	triangle := newGraph(3, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 0})
+	fmt.Println(len(iso.NewMatcher(g, triangle).SubgraphIsomorphisms(0)))
+
+	// the square is a subgraph of g, but not an induced one
+	square := newGraph(4, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 0})
+	m := iso.NewMatcher(g, square)
+	_, ok := m.SubgraphIsomorphism()
+	fmt.Println(ok)
+	fmt.Println(m.SubgraphMonomorphism())
+
+	// Output:
+	// [1 0 3 2] true
+	// 12
+	// false
+	// [0 1 2 3] true
+}
+
+func ExampleNewDigraphMatcher() {
+	// the dependencies a->b->c and a->c, twice, with other names
+	g := newDigraph(3, [2]int{0, 1}, [2]int{1, 2}, [2]int{0, 2})
+	h := newDigraph(3, [2]int{2, 0}, [2]int{0, 1}, [2]int{2, 1})
+	fmt.Println(iso.NewDigraphMatcher(g, h).Isomorphism())
+
+	// a depends on b and c, or b and c depend on a
+	out := newDigraph(3, [2]int{0, 1}, [2]int{0, 2})
+	in := newDigraph(3, [2]int{1, 0}, [2]int{2, 0})
+	fmt.Println(iso.NewDigraphMatcher(out, in).Isomorphic())
+	fmt.Println(iso.WeisfeilerLehmanDigraphHash(out, 2) == iso.WeisfeilerLehmanDigraphHash(in, 2))
+
+	// Output:
+	// [1 2 0] true
+	// false
+	// false
+}
@@ -0,0 +1,188 @@
+package iso
+
+import (
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+type edgeList [][2]int
+
+// a random multigraph with self-loops
+func randomEdges(r *testutil.Random, n, e int) edgeList {
+	edges := make(edgeList, e)
+	for i := range edges {
+		edges[i] = [2]int{r.Intn(n), r.Intn(n)}
+	}
+	return edges
+}
+
+func (edges edgeList) graph(n int, directed bool) graph.IGraph {
+	var g graph.IGraph = graph.NewGraphN(n)
+	if directed {
+		g = digraph.NewDigraphN(n)
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func (edges edgeList) permuted(p []int) edgeList {
+	permuted := make(edgeList, len(edges))
+	for i, e := range edges {
+		permuted[i] = [2]int{p[e[0]], p[e[1]]}
+	}
+	return permuted
+}
+
+func matcher(g, h graph.IGraph, directed bool) *Matcher {
+	if directed {
+		return NewDigraphMatcher(g, h)
+	}
+	return NewMatcher(g, h)
+}
+
+// the number of edges v-w (or v->w) in g
+func multiplicity(g graph.IGraph, v, w int) int {
+	count := 0
+	for _, x := range g.Adj(v) {
+		if x.(int) == w {
+			count++
+		}
+	}
+	return count
+}
+
+// Is m a map of h into g? For induced and isomorphism, the edges between the
+// matched vertices are the same, for monomorphism there may be more in g.
+func isMatch(g, h graph.IGraph, m []int, p problem) bool {
+	used := make(map[int]bool)
+	for _, v := range m {
+		if v < 0 || v >= g.V() || used[v] {
+			return false
+		}
+		used[v] = true
+	}
+	for x := 0; x < h.V(); x++ {
+		for y := 0; y < h.V(); y++ {
+			inG, inH := multiplicity(g, m[x], m[y]), multiplicity(h, x, y)
+			if inG < inH || p != monomorphism && inG != inH {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// counts the maps of h into g by trying all of them
+func bruteForce(g, h graph.IGraph, p problem) int {
+	if p == isomorphism && g.V() != h.V() {
+		return 0
+	}
+	count := 0
+	m := make([]int, h.V())
+	var try func(i int)
+	try = func(i int) {
+		if i == len(m) {
+			if isMatch(g, h, m, p) {
+				count++
+			}
+			return
+		}
+		for v := 0; v < g.V(); v++ {
+			m[i] = v
+			try(i + 1)
+		}
+	}
+	try(0)
+	return count
+}
+
+func TestIsomorphism_permuted(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	for i := 0; i < 400; i++ {
+		directed := i%2 == 1
+		n := 1 + r.Intn(12)
+		edges := randomEdges(r, n, r.Intn(3*n))
+		p := r.Perm(n)
+		g, h := edges.graph(n, directed), edges.permuted(p).graph(n, directed)
+
+		if WeisfeilerLehmanHash(g, 3) != WeisfeilerLehmanHash(h, 3) && !directed ||
+			directed && WeisfeilerLehmanDigraphHash(g, 3) != WeisfeilerLehmanDigraphHash(h, 3) {
+			t.Fatalf("case %d: different hashes", i)
+		}
+		m, ok := matcher(g, h, directed).Isomorphism()
+		if !ok || !isMatch(g, h, m, isomorphism) {
+			t.Fatalf("case %d: %v %v is not an isomorphism", i, ok, m)
+		}
+	}
+}
+
+func TestMatcher_bruteForce(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	for i := 0; i < 600; i++ {
+		directed := i%2 == 1
+		n := 1 + r.Intn(5)
+		g := randomEdges(r, n, r.Intn(2*n+1)).graph(n, directed)
+
+		// a graph of the same size, for isomorphism
+		h := randomEdges(r, n, g.E()).graph(n, directed)
+		m := matcher(g, h, directed)
+		if m.Isomorphic() != (bruteForce(g, h, isomorphism) > 0) {
+			t.Fatalf("case %d: isomorphic: %v", i, m.Isomorphic())
+		}
+
+		// a smaller pattern
+		k := 1 + r.Intn(n)
+		h = randomEdges(r, k, r.Intn(2*k)).graph(k, directed)
+		m = matcher(g, h, directed)
+		for _, p := range []problem{induced, monomorphism} {
+			maps := m.search(p, 0)
+			for _, x := range maps {
+				if !isMatch(g, h, x, p) {
+					t.Fatalf("case %d, problem %d: %v is not a match", i, p, x)
+				}
+			}
+			if want := bruteForce(g, h, p); len(maps) != want {
+				t.Fatalf("case %d, problem %d: %d maps, want %d", i, p, len(maps), want)
+			}
+		}
+		if len(m.SubgraphMonomorphisms(2)) > 2 {
+			t.Fatalf("case %d: over the limit", i)
+		}
+	}
+}
+
+func TestWeisfeilerLehmanHash(t *testing.T) {
+	// a 6-cycle and two triangles: 2-regular graphs that WL cannot tell
+	// apart, but not isomorphic
+	cycle := edgeList{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}}.graph(6, false)
+	triangles := edgeList{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}}.graph(6, false)
+	if WeisfeilerLehmanHash(cycle, 3) != WeisfeilerLehmanHash(triangles, 3) {
+		t.Errorf("the hashes of regular graphs differ")
+	}
+	if NewMatcher(cycle, triangles).Isomorphic() {
+		t.Errorf("a 6-cycle and two triangles are isomorphic")
+	}
+
+	// a path and a star with 4 vertices
+	path := edgeList{{0, 1}, {1, 2}, {2, 3}}.graph(4, false)
+	star := edgeList{{0, 1}, {0, 2}, {0, 3}}.graph(4, false)
+	if WeisfeilerLehmanHash(path, 1) == WeisfeilerLehmanHash(star, 1) {
+		t.Errorf("a path and a star have the same hash")
+	}
+
+	// a digraph and its reverse
+	dag := edgeList{{0, 1}, {0, 2}, {0, 3}}.graph(4, true)
+	reverse := edgeList{{1, 0}, {2, 0}, {3, 0}}.graph(4, true)
+	if WeisfeilerLehmanDigraphHash(dag, 2) == WeisfeilerLehmanDigraphHash(reverse, 2) {
+		t.Errorf("a digraph and its reverse have the same hash")
+	}
+}
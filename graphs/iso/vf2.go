@@ -0,0 +1,452 @@
+package iso
+
+import (
+	"sort"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// VF2.
+// A Matcher looks for the maps of the vertices of a pattern graph h into the
+// vertices of a graph g that keep the edges, by a depth-first search: the
+// vertices of h are taken in turn, in an order where each vertex is adjacent
+// to an earlier one if it can be, and each is matched to a vertex of g that
+// is adjacent to the match of that earlier vertex. A pair is kept only if
+// 1. the edges between the two vertices and the vertices already matched are
+//    the same in both graphs;
+// 2. the two vertices have as many unmatched neighbors that are adjacent to
+//    matched vertices (the terminal sets of VF2), and as many other
+//    unmatched neighbors.
+// The search backtracks when no pair is left. There are three problems:
+// 1. isomorphism: h and g are the same graph, up to the names of the vertices;
+// 2. subgraph isomorphism: h is an induced subgraph of g, with all the edges
+//    of g between the matched vertices;
+// 3. subgraph monomorphism: h is a subgraph of g, some edges of g between
+//    the matched vertices may be missing in h.
+// For 2 and 3, the vertex of g must have at least as many edges (and
+// neighbors) as the vertex of h, instead of as many. For isomorphism, the
+// vertices must also have the same Weisfeiler-Lehman colors, which prunes
+// most of the search. The search is exponential in the worst case; it is for
+// small graphs, or graphs with few symmetries.
+// A map is given as a slice indexed by the vertices of h: m[w] is the vertex
+// of g matched with vertex w of h.
+
+// the rounds of Weisfeiler-Lehman colors that the vertices of isomorphic
+// graphs must agree on
+const isomorphismRounds = 3
+
+type problem int
+
+const (
+	isomorphism problem = iota
+	induced
+	monomorphism
+)
+
+type Matcher struct {
+	g, h *structure
+}
+
+// New a matcher of the pattern graph h in graph g
+func NewMatcher(g, h graph.IGraph) *Matcher {
+	return &Matcher{newStructure(g, false), newStructure(h, false)}
+}
+
+// New a matcher of the pattern digraph h in digraph g
+func NewDigraphMatcher(g, h digraph.IDigraph) *Matcher {
+	return &Matcher{newStructure(g, true), newStructure(h, true)}
+}
+
+// Are g and h isomorphic?
+func (m *Matcher) Isomorphic() bool {
+	_, ok := m.Isomorphism()
+	return ok
+}
+
+// Returns an isomorphism of h onto g, and true, or nil and false if the
+// graphs are not isomorphic
+func (m *Matcher) Isomorphism() ([]int, bool) {
+	return first(m.search(isomorphism, 1))
+}
+
+// Returns a map of h onto an induced subgraph of g, and true, or nil and
+// false if there is none
+func (m *Matcher) SubgraphIsomorphism() ([]int, bool) {
+	return first(m.search(induced, 1))
+}
+
+// Returns the maps of h onto the induced subgraphs of g, at most limit of
+// them, or all of them if limit <= 0. The automorphisms of h give several
+// maps onto the same subgraph.
+func (m *Matcher) SubgraphIsomorphisms(limit int) [][]int {
+	return m.search(induced, limit)
+}
+
+// Returns a map of h onto a subgraph of g, and true, or nil and false if
+// there is none
+func (m *Matcher) SubgraphMonomorphism() ([]int, bool) {
+	return first(m.search(monomorphism, 1))
+}
+
+// Returns the maps of h onto the subgraphs of g, at most limit of them, or
+// all of them if limit <= 0
+func (m *Matcher) SubgraphMonomorphisms(limit int) [][]int {
+	return m.search(monomorphism, limit)
+}
+
+func first(maps [][]int) ([]int, bool) {
+	if len(maps) == 0 {
+		return nil, false
+	}
+	return maps[0], true
+}
+
+// structure is a graph or digraph as sets of neighbors with multiplicities.
+// In an undirected graph, out and in are the same, and a self-loop counts
+// twice, as it is twice in the adjacency list.
+type structure struct {
+	directed  bool
+	n, e      int
+	out       []map[int]int // out[v][w]: number of edges v->w
+	in        []map[int]int // in[w][v]: number of edges v->w
+	nbrs      [][]int       // nbrs[v]: the vertices adjacent to or from v, but v
+	outDegree []int
+	inDegree  []int
+}
+
+func newStructure(g graph.IGraph, directed bool) *structure {
+	n := g.V()
+	s := &structure{
+		directed:  directed,
+		n:         n,
+		e:         g.E(),
+		out:       make([]map[int]int, n),
+		in:        make([]map[int]int, n),
+		nbrs:      make([][]int, n),
+		outDegree: make([]int, n),
+		inDegree:  make([]int, n),
+	}
+	for v := 0; v < n; v++ {
+		s.out[v] = make(map[int]int)
+	}
+	if directed {
+		for v := 0; v < n; v++ {
+			s.in[v] = make(map[int]int)
+		}
+	} else {
+		s.in = s.out
+	}
+
+	for v := 0; v < n; v++ {
+		adj := graph.Adjacent(g, v)
+		for i := 0; i < adj.Len(); i++ {
+			w := adj.At(i)
+			s.out[v][w]++
+			s.outDegree[v]++
+			if directed {
+				s.in[w][v]++
+				s.inDegree[w]++
+			}
+		}
+	}
+	if !directed {
+		copy(s.inDegree, s.outDegree)
+	}
+
+	for v := 0; v < n; v++ {
+		for w := range s.out[v] {
+			if w != v {
+				s.nbrs[v] = append(s.nbrs[v], w)
+			}
+		}
+		if directed {
+			for w := range s.in[v] {
+				if _, ok := s.out[v][w]; !ok && w != v {
+					s.nbrs[v] = append(s.nbrs[v], w)
+				}
+			}
+		}
+		// the maps have no order: sort for the same search every time
+		sort.Ints(s.nbrs[v])
+	}
+	return s
+}
+
+// the state of a search
+type vf2 struct {
+	g, h    *structure
+	problem problem
+	coreG   []int // coreG[v]: vertex of h matched with v, or -1
+	coreH   []int // coreH[w]: vertex of g matched with w, or -1
+	termG   []int // termG[v]: number of matched neighbors of v
+	termH   []int
+	colorG  []uint64 // Weisfeiler-Lehman colors, for isomorphism
+	colorH  []uint64
+}
+
+func (m *Matcher) search(p problem, limit int) [][]int {
+	g, h := m.g, m.h
+	if g.directed != h.directed {
+		panic("graph and digraph")
+	}
+	if p == isomorphism && (g.n != h.n || g.e != h.e) || h.n > g.n {
+		return nil
+	}
+
+	s := &vf2{
+		g:       g,
+		h:       h,
+		problem: p,
+		coreG:   filled(g.n, -1),
+		coreH:   filled(h.n, -1),
+		termG:   make([]int, g.n),
+		termH:   make([]int, h.n),
+	}
+	if p == isomorphism {
+		colorsG, colorsH := g.colors(isomorphismRounds), h.colors(isomorphismRounds)
+		s.colorG, s.colorH = colorsG[isomorphismRounds], colorsH[isomorphismRounds]
+		if !sameColors(s.colorG, s.colorH) {
+			return nil
+		}
+	}
+
+	order, parent := h.matchingOrder()
+	if len(order) == 0 {
+		return [][]int{{}}
+	}
+
+	// the search, with an explicit stack of the vertices of h in order
+	type frame struct {
+		candidates []int // the vertices of g to try
+		next       int   // index in candidates of the next one
+		matched    int   // the vertex of g matched, or -1
+	}
+	var maps [][]int
+	stack := []frame{{candidates: s.candidates(parent[order[0]]), matched: -1}}
+	for len(stack) > 0 {
+		d := len(stack) - 1
+		f := &stack[d]
+		w := order[d]
+		if f.matched >= 0 {
+			s.unmatch(f.matched, w)
+			f.matched = -1
+		}
+
+		for f.next < len(f.candidates) {
+			v := f.candidates[f.next]
+			f.next++
+			if s.coreG[v] < 0 && s.feasible(v, w) {
+				s.match(v, w)
+				f.matched = v
+				break
+			}
+		}
+		if f.matched < 0 {
+			stack = stack[:d]
+			continue
+		}
+
+		if d+1 == len(order) {
+			maps = append(maps, append([]int(nil), s.coreH...))
+			if limit > 0 && len(maps) >= limit {
+				break
+			}
+			continue
+		}
+		next := order[d+1]
+		stack = append(stack, frame{candidates: s.candidates(parent[next]), matched: -1})
+	}
+	return maps
+}
+
+func filled(n, x int) []int {
+	a := make([]int, n)
+	for i := range a {
+		a[i] = x
+	}
+	return a
+}
+
+func sameColors(a, b []uint64) bool {
+	a = append([]uint64(nil), a...)
+	b = append([]uint64(nil), b...)
+	sortUint64s(a)
+	sortUint64s(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the vertices in the order of the search, each one adjacent to an
+// earlier one when it can be: a breadth-first search of each component, from
+// its vertex of highest degree. parent[w] is the earlier neighbor of w, or -1.
+func (s *structure) matchingOrder() (order, parent []int) {
+	parent = filled(s.n, -1)
+	marked := make([]bool, s.n)
+
+	byDegree := make([]int, s.n)
+	for v := range byDegree {
+		byDegree[v] = v
+	}
+	degree := func(v int) int { return s.outDegree[v] + s.inDegree[v] }
+	sort.SliceStable(byDegree, func(i, j int) bool {
+		return degree(byDegree[i]) > degree(byDegree[j])
+	})
+
+	for _, root := range byDegree {
+		if marked[root] {
+			continue
+		}
+		marked[root] = true
+		for queue := []int{root}; len(queue) > 0; queue = queue[1:] {
+			v := queue[0]
+			order = append(order, v)
+			for _, w := range s.nbrs[v] {
+				if !marked[w] {
+					marked[w] = true
+					parent[w] = v
+					queue = append(queue, w)
+				}
+			}
+		}
+	}
+	return order, parent
+}
+
+// Returns the vertices of g that a vertex of h with the given parent may be
+// matched with: the neighbors of the match of the parent, or all the vertices
+func (s *vf2) candidates(parent int) []int {
+	if parent >= 0 {
+		return s.g.nbrs[s.coreH[parent]]
+	}
+	all := make([]int, s.g.n)
+	for v := range all {
+		all[v] = v
+	}
+	return all
+}
+
+// compares a number in g with the same number in h
+func (s *vf2) fits(inG, inH int) bool {
+	if s.problem == isomorphism {
+		return inG == inH
+	}
+	return inG >= inH
+}
+
+// Can vertex v of g be matched with vertex w of h?
+func (s *vf2) feasible(v, w int) bool {
+	g, h := s.g, s.h
+	if s.problem == isomorphism {
+		if s.colorG[v] != s.colorH[w] {
+			return false
+		}
+	} else if g.outDegree[v] < h.outDegree[w] || g.inDegree[v] < h.inDegree[w] {
+		return false
+	}
+
+	// the self-loops
+	if !s.edges(g.out[v][v], h.out[w][w]) {
+		return false
+	}
+
+	// the edges to and from the matched vertices
+	matched := 0
+	for x, k := range h.out[w] {
+		if y := s.coreH[x]; y >= 0 && x != w {
+			if !s.edges(g.out[v][y], k) {
+				return false
+			}
+			matched++
+		}
+	}
+	if s.problem != monomorphism {
+		// no more edges in g than in h
+		for y := range g.out[v] {
+			if s.coreG[y] >= 0 && y != v {
+				matched--
+			}
+		}
+		if matched != 0 {
+			return false
+		}
+	}
+	if g.directed {
+		matched = 0
+		for x, k := range h.in[w] {
+			if y := s.coreH[x]; y >= 0 && x != w {
+				if !s.edges(g.in[v][y], k) {
+					return false
+				}
+				matched++
+			}
+		}
+		if s.problem != monomorphism {
+			for y := range g.in[v] {
+				if s.coreG[y] >= 0 && y != v {
+					matched--
+				}
+			}
+			if matched != 0 {
+				return false
+			}
+		}
+	}
+
+	// look ahead: the unmatched neighbors, in the terminal sets or not
+	termG, newG := s.unmatched(g, v, s.coreG, s.termG)
+	termH, newH := s.unmatched(h, w, s.coreH, s.termH)
+	if s.problem == monomorphism {
+		// a neighbor that is new in h may be terminal in g
+		return termG >= termH && termG+newG >= termH+newH
+	}
+	return s.fits(termG, termH) && s.fits(newG, newH)
+}
+
+// compares the numbers of edges between two vertices in g and in h
+func (s *vf2) edges(inG, inH int) bool {
+	if s.problem == monomorphism {
+		return inG >= inH
+	}
+	return inG == inH
+}
+
+// Returns the numbers of unmatched neighbors of v adjacent to a matched
+// vertex, and not
+func (s *vf2) unmatched(g *structure, v int, core, term []int) (terminal, other int) {
+	for _, x := range g.nbrs[v] {
+		if core[x] >= 0 {
+			continue
+		}
+		if term[x] > 0 {
+			terminal++
+		} else {
+			other++
+		}
+	}
+	return terminal, other
+}
+
+func (s *vf2) match(v, w int) {
+	s.coreG[v], s.coreH[w] = w, v
+	for _, x := range s.g.nbrs[v] {
+		s.termG[x]++
+	}
+	for _, x := range s.h.nbrs[w] {
+		s.termH[x]++
+	}
+}
+
+func (s *vf2) unmatch(v, w int) {
+	s.coreG[v], s.coreH[w] = -1, -1
+	for _, x := range s.g.nbrs[v] {
+		s.termG[x]--
+	}
+	for _, x := range s.h.nbrs[w] {
+		s.termH[x]--
+	}
+}
@@ -0,0 +1,119 @@
+package iso
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"sort"
+
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+)
+
+// Graph isomorphism.
+// Two graphs are isomorphic if there is a one-to-one map of the vertices of
+// one onto the vertices of the other that keeps the edges (with their
+// multiplicities, self-loops included).
+// The Weisfeiler-Lehman hash colors the vertices by their degrees, then
+// repeatedly gives each vertex a new color made of its color and the sorted
+// colors of its neighbors (in a digraph, the colors of the vertices it points
+// to and of those that point to it, apart). The hash is made of the colors of
+// all the rounds. Isomorphic graphs have the same hash, so different hashes
+// prove that two graphs are not isomorphic; the same hash is only a strong
+// hint that they are (regular graphs of the same size, for example, cannot be
+// told apart). It takes O(k(V+E)log V) for k rounds.
+// The Matcher tells for sure, with VF2 (see vf2.go).
+
+// Returns the Weisfeiler-Lehman hash of graph g, after the given number of
+// rounds of color refinement
+func WeisfeilerLehmanHash(g graph.IGraph, rounds int) uint64 {
+	return newStructure(g, false).hash(rounds)
+}
+
+// Returns the Weisfeiler-Lehman hash of digraph g, after the given number of
+// rounds of color refinement
+func WeisfeilerLehmanDigraphHash(g digraph.IDigraph, rounds int) uint64 {
+	return newStructure(g, true).hash(rounds)
+}
+
+func (s *structure) hash(rounds int) uint64 {
+	colors := s.colors(rounds)
+
+	h := newHasher()
+	h.add(uint64(s.n), uint64(s.e))
+	for _, round := range colors {
+		sorted := append([]uint64(nil), round...)
+		sortUint64s(sorted)
+		h.add(sorted...)
+	}
+	return h.sum()
+}
+
+// Returns the colors of the vertices after each round, from round 0 (the
+// degrees) to the given round
+func (s *structure) colors(rounds int) [][]uint64 {
+	color := make([]uint64, s.n)
+	for v := 0; v < s.n; v++ {
+		h := newHasher()
+		h.add(uint64(s.outDegree[v]), uint64(s.inDegree[v]))
+		color[v] = h.sum()
+	}
+	colors := [][]uint64{color}
+
+	var out, in []uint64
+	for r := 0; r < rounds; r++ {
+		next := make([]uint64, s.n)
+		for v := 0; v < s.n; v++ {
+			out = s.neighborColors(out[:0], s.out[v], color)
+			h := newHasher()
+			h.add(color[v])
+			h.add(out...)
+			if s.directed {
+				in = s.neighborColors(in[:0], s.in[v], color)
+				h.add(^uint64(0)) // separates the two lists
+				h.add(in...)
+			}
+			next[v] = h.sum()
+		}
+		color = next
+		colors = append(colors, color)
+	}
+	return colors
+}
+
+// appends the sorted colors of the neighbors, each as many times as there
+// are edges to it
+func (s *structure) neighborColors(dst []uint64, adj map[int]int, color []uint64) []uint64 {
+	for w, m := range adj {
+		for i := 0; i < m; i++ {
+			dst = append(dst, color[w])
+		}
+	}
+	sortUint64s(dst)
+	return dst
+}
+
+func sortUint64s(a []uint64) {
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+}
+
+// hasher is a 64-bit FNV-1a hash of a sequence of numbers
+type hasher struct {
+	buf [8]byte
+	h   hash.Hash64
+}
+
+func newHasher() *hasher {
+	return &hasher{h: fnv.New64a()}
+}
+
+func (h *hasher) add(xs ...uint64) {
+	for _, x := range xs {
+		binary.LittleEndian.PutUint64(h.buf[:], x)
+		h.h.Write(h.buf[:])
+	}
+}
+
+func (h *hasher) sum() uint64 {
+	return h.h.Sum64()
+}
@@ -0,0 +1,49 @@
+package labeled_test
+
+import (
+	"fmt"
+
+	"github.com/youngzhu/algs4-go/graphs/labeled"
+)
+
+type road struct {
+	name string
+	km   float64
+}
+
+func ExampleLabeledGraph() {
+	g := labeled.NewLabeledGraph[string, road]()
+	g.AddEdge("Paris", "Lyon", road{"A6", 465})
+	g.AddEdge("Lyon", "Marseille", road{"A7", 315})
+	g.AddEdge("Paris", "Bordeaux", road{"A10", 585})
+	g.AddEdge("Bordeaux", "Toulouse", road{"A62", 245})
+	g.AddEdge("Toulouse", "Marseille", road{"A61", 405})
+	g.AddEdge("Paris", "Marseille", road{"N7", 860})
+	g.AddVertex("Ajaccio")
+
+	km := func(r road) float64 { return r.km }
+
+	fmt.Println(g.ShortestPath("Paris", "Toulouse"))
+
+	path, length, _ := g.WeightedShortestPath("Paris", "Toulouse", km)
+	for _, e := range path {
+		fmt.Printf("%s-%s %s\n", e.From, e.To, e.Value.name)
+	}
+	fmt.Println(length)
+
+	forest, weight := g.MinimumSpanningForest(km)
+	for _, e := range forest {
+		fmt.Print(e.Value.name, " ")
+	}
+	fmt.Println(weight)
+
+	fmt.Println(g.Components())
+
+	// Output:
+	// [Paris Marseille Toulouse]
+	// Paris-Bordeaux A10
+	// Bordeaux-Toulouse A62
+	// 830
+	// A62 A7 A61 A6 1430
+	// [[Paris Lyon Marseille Bordeaux Toulouse] [Ajaccio]]
+}
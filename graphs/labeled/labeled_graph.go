@@ -0,0 +1,267 @@
+package labeled
+
+import (
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/graphs/mst"
+	"github.com/youngzhu/algs4-go/graphs/sp"
+)
+
+// Labeled graphs.
+// The graphs of the other packages have the vertices 0 to V-1, and edges
+// with at most a weight. A LabeledGraph has vertices of any comparable type
+// V (names, ids, structs), and edges that carry a value of any type E. It
+// is a SymbolGraph for any type of vertex:
+// 1. a map from the vertices to indices 0 to V-1, and a slice back;
+// 2. the edges, with their values, in a slice: an edge is known by its index;
+// 3. a Graph or Digraph of the indices, the core, that the algorithms of
+//    package graph and digraph run on.
+// The algorithms that need weights (Dijkstra, MST) take a function that
+// gives the weight of an edge value, and run on an edge-weighted core made
+// for the call. The results are given back in vertices and edges of the
+// labeled graph.
+
+// Edge is an edge of a labeled graph, with its value. In an undirected
+// graph, From and To are the two vertices, in the order of the question: the
+// edges incident on v are from v, and the edges of a path follow the path.
+type Edge[V comparable, E any] struct {
+	From, To V
+	Value    E
+}
+
+type edge[E any] struct {
+	from, to int
+	value    E
+}
+
+// the core graph of the indices: *graph.Graph or *digraph.Digraph
+type core interface {
+	graph.IGraph
+	AddVertex() int
+}
+
+type LabeledGraph[V comparable, E any] struct {
+	directed bool
+	index    map[V]int // vertex -> index
+	vertices []V       // index -> vertex
+	edges    []edge[E] // the edges, in the order they were added
+	adj      [][]int   // adj[v]: the edges incident from (or on) index v
+	core     core
+}
+
+// New an empty undirected labeled graph
+func NewLabeledGraph[V comparable, E any]() *LabeledGraph[V, E] {
+	return &LabeledGraph[V, E]{index: make(map[V]int), core: graph.NewGraphN(0)}
+}
+
+// New an empty labeled digraph
+func NewLabeledDigraph[V comparable, E any]() *LabeledGraph[V, E] {
+	return &LabeledGraph[V, E]{directed: true, index: make(map[V]int), core: digraph.NewDigraphN(0)}
+}
+
+// Is the graph directed?
+func (g *LabeledGraph[V, E]) Directed() bool {
+	return g.directed
+}
+
+// Returns the number of vertices
+func (g *LabeledGraph[V, E]) V() int {
+	return len(g.vertices)
+}
+
+// Returns the number of edges
+func (g *LabeledGraph[V, E]) E() int {
+	return len(g.edges)
+}
+
+// Adds vertex v, if it is not in the graph, and returns its index
+func (g *LabeledGraph[V, E]) AddVertex(v V) int {
+	if i, ok := g.index[v]; ok {
+		return i
+	}
+	i := g.core.AddVertex()
+	g.index[v] = i
+	g.vertices = append(g.vertices, v)
+	g.adj = append(g.adj, nil)
+	return i
+}
+
+// Adds the edge from-to with the given value, and its vertices if they are
+// not in the graph. Parallel edges and self-loops are allowed.
+func (g *LabeledGraph[V, E]) AddEdge(from, to V, value E) {
+	v, w := g.AddVertex(from), g.AddVertex(to)
+	id := len(g.edges)
+	g.edges = append(g.edges, edge[E]{v, w, value})
+	g.adj[v] = append(g.adj[v], id)
+	if !g.directed && v != w {
+		g.adj[w] = append(g.adj[w], id)
+	}
+	g.core.AddEdge(v, w)
+}
+
+// Is v a vertex of the graph?
+func (g *LabeledGraph[V, E]) Contains(v V) bool {
+	_, ok := g.index[v]
+	return ok
+}
+
+// Returns the index of vertex v in the core graph,
+// and false if there is no such vertex
+func (g *LabeledGraph[V, E]) Index(v V) (int, bool) {
+	i, ok := g.index[v]
+	return i, ok
+}
+
+// Returns the vertex of index i in the core graph
+func (g *LabeledGraph[V, E]) Vertex(i int) V {
+	g.validateIndex(i)
+	return g.vertices[i]
+}
+
+// Returns the vertices, in the order of their indices
+func (g *LabeledGraph[V, E]) Vertices() []V {
+	return append([]V(nil), g.vertices...)
+}
+
+// Returns the edges, in the order they were added
+func (g *LabeledGraph[V, E]) Edges() []Edge[V, E] {
+	edges := make([]Edge[V, E], len(g.edges))
+	for id, e := range g.edges {
+		edges[id] = g.edge(id, e.from)
+	}
+	return edges
+}
+
+// Returns the edges incident from v, or on v if the graph is undirected
+func (g *LabeledGraph[V, E]) Adj(v V) []Edge[V, E] {
+	i := g.mustIndex(v)
+	edges := make([]Edge[V, E], len(g.adj[i]))
+	for k, id := range g.adj[i] {
+		edges[k] = g.edge(id, i)
+	}
+	return edges
+}
+
+// Returns the core graph of the indices, a *graph.Graph or a
+// *digraph.Digraph, to run other algorithms on. It must not be modified.
+func (g *LabeledGraph[V, E]) Core() graph.IGraph {
+	return g.core
+}
+
+// the edge id, from the index v
+func (g *LabeledGraph[V, E]) edge(id, v int) Edge[V, E] {
+	e := g.edges[id]
+	if e.from != v {
+		e.from, e.to = e.to, e.from
+	}
+	return Edge[V, E]{g.vertices[e.from], g.vertices[e.to], e.value}
+}
+
+func (g *LabeledGraph[V, E]) mustIndex(v V) int {
+	i, ok := g.index[v]
+	if !ok {
+		panic("no such vertex")
+	}
+	return i
+}
+
+func (g *LabeledGraph[V, E]) validateIndex(i int) {
+	if i < 0 || i >= len(g.vertices) {
+		panic("invalidate vertex")
+	}
+}
+
+// Returns a path from vertex from to vertex to with the fewest edges, or nil
+// if there is no such path, see graph.BreadthFirstPaths
+func (g *LabeledGraph[V, E]) ShortestPath(from, to V) []V {
+	s, t := g.mustIndex(from), g.mustIndex(to)
+	bfs := graph.NewBreadthFirstPathsOf(g.core, s)
+	if !bfs.HasPathTo(t) {
+		return nil
+	}
+	var path []V
+	for _, v := range bfs.PathTo(t) {
+		path = append(path, g.vertices[v])
+	}
+	return path
+}
+
+// Returns a shortest path from vertex from to vertex to, where the length of
+// an edge is weight(value), and its length; or false if there is no such
+// path. The weights must not be negative, see sp.DijkstraSP.
+func (g *LabeledGraph[V, E]) WeightedShortestPath(from, to V, weight func(E) float64) ([]Edge[V, E], float64, bool) {
+	s, t := g.mustIndex(from), g.mustIndex(to)
+
+	// an undirected edge is an edge each way
+	ewd := digraph.NewEdgeWeightedDigraphN(g.V())
+	ids := make(map[*digraph.DirectedEdge]int) // core edge -> edge id
+	for id, e := range g.edges {
+		w := weight(e.value)
+		de := digraph.NewDirectedEdge(e.from, e.to, w)
+		ids[de] = id
+		ewd.AddEdge(de)
+		if !g.directed && e.from != e.to {
+			de = digraph.NewDirectedEdge(e.to, e.from, w)
+			ids[de] = id
+			ewd.AddEdge(de)
+		}
+	}
+
+	dijkstra := sp.NewDijkstraSPOf(ewd, s)
+	if !dijkstra.HasPathTo(t) {
+		return nil, 0, false
+	}
+	var path []Edge[V, E]
+	for _, x := range dijkstra.PathTo(t) {
+		de := x.(*digraph.DirectedEdge)
+		path = append(path, g.edge(ids[de], de.From()))
+	}
+	return path, dijkstra.DistTo(t), true
+}
+
+// Returns a minimum spanning forest of an undirected graph, where the weight
+// of an edge is weight(value), and its weight, see mst.KruskalMST.
+// Panics if the graph is directed.
+func (g *LabeledGraph[V, E]) MinimumSpanningForest(weight func(E) float64) ([]Edge[V, E], float64) {
+	if g.directed {
+		panic("minimum spanning forest of a digraph")
+	}
+
+	ewg := mst.NewEdgeWeightedGraphN(g.V())
+	ids := make(map[*mst.Edge]int) // core edge -> edge id
+	for id, e := range g.edges {
+		me := mst.NewEdge(e.from, e.to, weight(e.value))
+		ids[me] = id
+		ewg.AddEdge(me)
+	}
+
+	kruskal := mst.NewKruskalMST(*ewg)
+	var forest []Edge[V, E]
+	for _, x := range kruskal.Edges() {
+		id := ids[x.(*mst.Edge)]
+		forest = append(forest, g.edge(id, g.edges[id].from))
+	}
+	return forest, kruskal.Weight()
+}
+
+// Returns the strong components of a digraph (see digraph.TarjanSCC), or the
+// connected components of an undirected graph (see graph.ConnectedComponents).
+// The components are in the order of their ids, and their vertices in the
+// order of their indices.
+func (g *LabeledGraph[V, E]) Components() [][]V {
+	var count int
+	var id func(v int) int
+	if g.directed {
+		scc := digraph.NewTarjanSCC(g.core)
+		count, id = scc.Count(), scc.Id
+	} else {
+		cc := graph.NewConnectedComponentsOf(g.core)
+		count, id = cc.Count(), cc.Id
+	}
+
+	components := make([][]V, count)
+	for v, x := range g.vertices {
+		components[id(v)] = append(components[id(v)], x)
+	}
+	return components
+}
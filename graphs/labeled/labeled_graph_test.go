@@ -0,0 +1,146 @@
+package labeled
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+type road struct {
+	name string
+	km   float64
+}
+
+func km(r road) float64 {
+	return r.km
+}
+
+// the results on the labels are those on the core, and the edges are
+// oriented along the paths
+func TestLabeledGraph_random(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	for i := 0; i < 50; i++ {
+		g := NewLabeledGraph[string, road]()
+		if i%2 == 1 {
+			g = NewLabeledDigraph[string, road]()
+		}
+		n := 20
+		for k := 0; k < n; k++ {
+			g.AddVertex(fmt.Sprint("v", k))
+		}
+		for k := 0; k < 30; k++ {
+			from, to := fmt.Sprint("v", r.Intn(n)), fmt.Sprint("v", r.Intn(n))
+			g.AddEdge(from, to, road{fmt.Sprint("e", k), float64(1 + r.Intn(9))})
+		}
+
+		// distances by Floyd-Warshall
+		dist := make([][]float64, n)
+		hops := make([][]float64, n)
+		for v := range dist {
+			dist[v], hops[v] = make([]float64, n), make([]float64, n)
+			for w := range dist[v] {
+				dist[v][w], hops[v][w] = math.Inf(1), math.Inf(1)
+			}
+			dist[v][v], hops[v][v] = 0, 0
+		}
+		for _, e := range g.Edges() {
+			v, _ := g.Index(e.From)
+			w, _ := g.Index(e.To)
+			if v == w {
+				continue
+			}
+			dist[v][w], hops[v][w] = math.Min(dist[v][w], e.Value.km), 1
+			if !g.Directed() {
+				dist[w][v], hops[w][v] = dist[v][w], 1
+			}
+		}
+		for x := 0; x < n; x++ {
+			for v := 0; v < n; v++ {
+				for w := 0; w < n; w++ {
+					dist[v][w] = math.Min(dist[v][w], dist[v][x]+dist[x][w])
+					hops[v][w] = math.Min(hops[v][w], hops[v][x]+hops[x][w])
+				}
+			}
+		}
+
+		for v := 0; v < n; v++ {
+			for w := 0; w < n; w++ {
+				from, to := g.Vertex(v), g.Vertex(w)
+				path := g.ShortestPath(from, to)
+				if math.IsInf(hops[v][w], 1) != (path == nil) || path != nil && float64(len(path)-1) != hops[v][w] {
+					t.Fatalf("graph %d: path %s-%s: %v, want %v hops", i, from, to, path, hops[v][w])
+				}
+
+				edges, length, ok := g.WeightedShortestPath(from, to, km)
+				if ok == math.IsInf(dist[v][w], 1) || ok && length != dist[v][w] {
+					t.Fatalf("graph %d: weighted path %s-%s: %v, want %v", i, from, to, length, dist[v][w])
+				}
+				sum, at := 0.0, from
+				for _, e := range edges {
+					if e.From != at {
+						t.Fatalf("graph %d: path %v: not from %s", i, edges, at)
+					}
+					sum += e.Value.km
+					at = e.To
+				}
+				if ok && (at != to || sum != length) {
+					t.Fatalf("graph %d: path %v: to %s, length %v", i, edges, at, sum)
+				}
+			}
+		}
+
+		components := g.Components()
+		size := 0
+		for _, c := range components {
+			size += len(c)
+			for _, x := range c {
+				v, _ := g.Index(x)
+				y, _ := g.Index(c[0])
+				if dist[v][y] == math.Inf(1) && !g.Directed() || g.Directed() && (math.IsInf(dist[v][y], 1) || math.IsInf(dist[y][v], 1)) {
+					t.Fatalf("graph %d: %s and %s in the same component", i, x, c[0])
+				}
+			}
+		}
+		if size != n {
+			t.Fatalf("graph %d: %d vertices in components", i, size)
+		}
+
+		if !g.Directed() {
+			forest, weight := g.MinimumSpanningForest(km)
+			cc := graph.NewConnectedComponentsOf(g.Core())
+			if len(forest) != n-cc.Count() {
+				t.Fatalf("graph %d: %d edges in the forest, want %d", i, len(forest), n-cc.Count())
+			}
+			sum := 0.0
+			for _, e := range forest {
+				sum += e.Value.km
+			}
+			if sum != weight {
+				t.Fatalf("graph %d: forest weight %v, want %v", i, sum, weight)
+			}
+		}
+	}
+}
+
+func TestLabeledGraph_adj(t *testing.T) {
+	g := NewLabeledGraph[int, string]()
+	g.AddEdge(1, 2, "a")
+	g.AddEdge(3, 1, "b")
+	g.AddEdge(1, 1, "loop")
+
+	want := "[{1 2 a} {1 3 b} {1 1 loop}]"
+	if got := fmt.Sprint(g.Adj(1)); got != want {
+		t.Errorf("Adj(1): got %s, want %s", got, want)
+	}
+	if got := fmt.Sprint(g.Adj(3)); got != "[{3 1 b}]" {
+		t.Errorf("Adj(3): got %s", got)
+	}
+	if g.V() != 3 || g.E() != 3 || g.Core().E() != 3 {
+		t.Errorf("got %d vertices, %d edges", g.V(), g.E())
+	}
+}
@@ -0,0 +1,52 @@
+package mst_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/mst"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestContext(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	g := mst.NewEdgeWeightedGraphGenerator(r).Random(3000, 9000)
+	want := mst.NewKruskalMST(*g).Weight()
+
+	// the same weights, and progress up to the vertices (or edges) processed
+	last := 0
+	progress := func(n int) { last = n }
+
+	lazy, err := mst.NewLazyPrimMSTContext(context.Background(), *g, progress)
+	if err != nil || math.Abs(lazy.Weight()-want) > 1e-9 || last != g.V() {
+		t.Errorf("LazyPrimMST: %v, weight %v, progress %d", err, lazy.Weight(), last)
+	}
+	prim, err := mst.NewPrimMSTContext(context.Background(), *g, progress)
+	if err != nil || math.Abs(prim.Weight()-want) > 1e-9 || last != g.V() {
+		t.Errorf("PrimMST: %v, weight %v, progress %d", err, prim.Weight(), last)
+	}
+	kruskal, err := mst.NewKruskalMSTContext(context.Background(), *g, progress)
+	if err != nil || kruskal.Weight() != want || last < g.V()-1 || last > g.E() {
+		t.Errorf("KruskalMST: %v, weight %v, progress %d", err, kruskal.Weight(), last)
+	}
+
+	// canceled at the first report
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := func(n int) {
+		last = n
+		cancel()
+	}
+	if _, err := mst.NewLazyPrimMSTContext(ctx, *g, stop); err != context.Canceled || last != graphs.CheckInterval {
+		t.Errorf("LazyPrimMST: %v after %d vertices", err, last)
+	}
+	if _, err := mst.NewPrimMSTContext(ctx, *g, nil); err != context.Canceled {
+		t.Errorf("PrimMST: %v", err)
+	}
+	if _, err := mst.NewKruskalMSTContext(ctx, *g, nil); err != context.Canceled {
+		t.Errorf("KruskalMST: %v", err)
+	}
+}
@@ -1,7 +1,10 @@
 package mst
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/sorting/pq"
 )
 
@@ -43,6 +46,13 @@ type PrimMST struct {
 const positiveInfinity = 1000000.0
 
 func NewPrimMST(g EdgeWeightedGraph) *PrimMST {
+	mst, _ := NewPrimMSTContext(context.Background(), g, nil)
+	return mst
+}
+
+// Same as NewPrimMST, but stops and returns ctx.Err() when ctx is done, see
+// graphs.Tracker. progress may be nil.
+func NewPrimMSTContext(ctx context.Context, g EdgeWeightedGraph, progress graphs.Progress) (*PrimMST, error) {
 	n := g.V()
 	edgeTo := make([]*Edge, n)
 	distTo := make([]distance, n)
@@ -56,20 +66,24 @@ func NewPrimMST(g EdgeWeightedGraph) *PrimMST {
 	mst := &PrimMST{g, edgeTo, distTo, marked, ipq}
 
 	// run from each vertex to find minimum spanning forest
-	for v := 0; v < n; v++ {
+	t := graphs.NewTracker(ctx, progress)
+	for v := 0; v < n && t.OK(); v++ {
 		if !mst.marked[v] {
-			mst.prim(v)
+			mst.prim(v, t)
 		}
 	}
+	if err := t.Done(); err != nil {
+		return nil, err
+	}
 
-	return mst
+	return mst, nil
 }
 
-// run Prim's algorithm starting from vertex s
-func (p *PrimMST) prim(s int) {
+// run Prim's algorithm starting from vertex s, until t says to stop
+func (p *PrimMST) prim(s int, t *graphs.Tracker) {
 	p.distTo[s] = 0
 	p.ipq.Insert(s, p.distTo[s])
-	for ! p.ipq.IsEmpty() {
+	for ! p.ipq.IsEmpty() && t.Visit() {
 		v := p.ipq.Delete()
 		p.scan(v)
 	}
@@ -10,7 +10,21 @@ import (
 type EdgeWeightedGraph struct {
 	vertices int // number of vertices
 	edges    int // number of edges
-	adj      []*fund.Bag
+	adj      []*fund.List
+}
+
+// New an empty edge-weighted graph with n vertices and 0 edges
+func NewEdgeWeightedGraphN(n int) *EdgeWeightedGraph {
+	if n < 0 {
+		panic("Number of vertices must be non-negative")
+	}
+
+	adj := make([]*fund.List, n)
+	for v := 0; v < n; v++ {
+		adj[v] = fund.NewList()
+	}
+
+	return &EdgeWeightedGraph{n, 0, adj}
 }
 
 func NewEdgeWeightedGraphIn(in *testutil.In) *EdgeWeightedGraph {
@@ -22,9 +36,9 @@ func NewEdgeWeightedGraphIn(in *testutil.In) *EdgeWeightedGraph {
 	if vertices < 0 {
 		panic("Number of vertices must be non-negative")
 	}
-	adj := make([]*fund.Bag, vertices)
+	adj := make([]*fund.List, vertices)
 	for v := 0; v < vertices; v++ {
-		adj[v] = fund.NewBag()
+		adj[v] = fund.NewList()
 	}
 
 	edges := in.ReadInt()
@@ -122,3 +136,91 @@ func (g *EdgeWeightedGraph) validateVertex(v int) {
 		panic("invalidate vertex")
 	}
 }
+
+// HasEdge returns true if this edge-weighted graph has an edge v-w
+func (g *EdgeWeightedGraph) HasEdge(v, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	for _, edge := range g.adj[v].Iterator() {
+		if edge.(*Edge).Other(v) == w {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveEdge removes the edge e from this edge-weighted graph.
+// Returns false if e is not in the graph.
+func (g *EdgeWeightedGraph) RemoveEdge(e *Edge) bool {
+	v := e.Either()
+	w := e.Other(v)
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.adj[v].Remove(e) {
+		return false
+	}
+	// a self-loop appears in the adjacency list twice
+	g.adj[w].Remove(e)
+	g.edges--
+	return true
+}
+
+// AddVertex adds an isolated vertex to this edge-weighted graph and returns it
+func (g *EdgeWeightedGraph) AddVertex() int {
+	g.adj = append(g.adj, fund.NewList())
+	g.vertices++
+	return g.vertices - 1
+}
+
+// RemoveVertex removes the vertex v and the edges incident on it.
+// The vertices stay numbered 0 to V-1: the last vertex takes the number v,
+// and its edges are replaced by new edges with the new number.
+// Returns the number the vertex now numbered v had before, which is v
+// itself if v was the last vertex.
+func (g *EdgeWeightedGraph) RemoveVertex(v int) int {
+	g.validateVertex(v)
+
+	selfLoops := 0
+	for _, edge := range g.adj[v].Iterator() {
+		e := edge.(*Edge)
+		if w := e.Other(v); w == v {
+			selfLoops++
+		} else {
+			g.adj[w].Remove(e)
+			g.edges--
+		}
+	}
+	g.edges -= selfLoops / 2
+
+	last := g.vertices - 1
+	if v != last {
+		renumbered := make(map[*Edge]*Edge)
+		for _, edge := range g.adj[last].Iterator() {
+			e := edge.(*Edge)
+			ne, ok := renumbered[e]
+			if !ok {
+				ne = NewEdge(renumber(e.v, last, v), renumber(e.w, last, v), e.weight)
+				renumbered[e] = ne
+			}
+			// a self-loop is replaced twice in the list of last
+			if w := e.Other(last); w != last {
+				g.adj[w].Replace(e, ne)
+			}
+			g.adj[last].Replace(e, ne)
+		}
+		g.adj[v] = g.adj[last]
+	}
+
+	g.adj[last] = nil
+	g.adj = g.adj[:last]
+	g.vertices--
+	return last
+}
+
+// returns x, or to if x is from
+func renumber(x, from, to int) int {
+	if x == from {
+		return to
+	}
+	return x
+}
@@ -2,7 +2,9 @@ package mst_test
 
 import (
 	"fmt"
+	"math"
 
+	"github.com/youngzhu/algs4-go/graphs/graph"
 	"github.com/youngzhu/algs4-go/graphs/mst"
 	"github.com/youngzhu/algs4-go/testutil"
 )
@@ -35,6 +37,34 @@ func ExampleEdgeWeightedGraph() {
 	// 7: 2-7 0.34000 1-7 0.19000 0-7 0.16000 5-7 0.28000 4-7 0.37000
 }
 
+func ExampleEdgeWeightedGraph_RemoveVertex() {
+	in := testutil.NewInReadWords("testdata/tinyEWG.txt")
+	g := mst.NewEdgeWeightedGraphIn(in)
+
+	for _, e := range g.Adj(6) {
+		if e.(*mst.Edge).Other(6) == 4 {
+			g.RemoveEdge(e.(*mst.Edge))
+		}
+	}
+	// the last vertex, 7, is renumbered 0
+	moved := g.RemoveVertex(0)
+	fmt.Printf("vertex %d is now vertex 0\n", moved)
+	fmt.Print(g)
+	fmt.Println(g.HasEdge(0, 5), g.HasEdge(4, 6))
+
+	// Output:
+	// vertex 7 is now vertex 0
+	// vertices:7, edges:11
+	// 0: 2-0 0.34000 1-0 0.19000 5-0 0.28000 4-0 0.37000
+	// 1: 1-3 0.29000 1-2 0.36000 1-0 0.19000 1-5 0.32000
+	// 2: 6-2 0.40000 2-0 0.34000 1-2 0.36000 2-3 0.17000
+	// 3: 3-6 0.52000 1-3 0.29000 2-3 0.17000
+	// 4: 4-0 0.37000 4-5 0.35000
+	// 5: 1-5 0.32000 5-0 0.28000 4-5 0.35000
+	// 6: 3-6 0.52000 6-2 0.40000
+	// true false
+}
+
 func ExampleLazyPrimMST_tinyEWG() {
 	mst := mst.NewLazyPrimMST(*tinyEWG)
 
@@ -104,3 +134,18 @@ func ExampleKruskalMST() {
 	// 6-2 0.40000
 	// 1.81000
 }
+
+func ExampleEdgeWeightedGraphGenerator() {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	g := graph.NewGraphGenerator(r).Complete(20)
+	ewg := mst.NewEdgeWeightedGraphGenerator(r).Weighted(g)
+
+	kruskal := mst.NewKruskalMST(*ewg)
+	prim := mst.NewPrimMST(*ewg)
+	fmt.Println(ewg.E(), math.Abs(kruskal.Weight()-prim.Weight()) < 1e-12)
+
+	// Output:
+	// 190 true
+}
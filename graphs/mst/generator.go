@@ -0,0 +1,67 @@
+package mst
+
+import (
+	"github.com/youngzhu/algs4-go/graphs/graph"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+// Edge-weighted graph generator.
+// Gives random weights to the edges of a graph, for instance one made by a
+// graph.GraphGenerator sharing the same testutil.Random, or makes a random
+// edge-weighted graph directly.
+
+type EdgeWeightedGraphGenerator struct {
+	r *testutil.Random
+
+	Lo, Hi float64 // the weights are uniform in [Lo, Hi)
+}
+
+// NewEdgeWeightedGraphGenerator returns a generator of weights uniform in
+// [0, 1), using r for the random choices.
+// If r is nil, a new (time-seeded) testutil.Random is used.
+func NewEdgeWeightedGraphGenerator(r *testutil.Random) *EdgeWeightedGraphGenerator {
+	if r == nil {
+		r = testutil.NewRandom()
+	}
+	return &EdgeWeightedGraphGenerator{r: r, Lo: 0, Hi: 1}
+}
+
+// Returns an edge-weighted graph with the edges of g, each with a random weight
+func (gen *EdgeWeightedGraphGenerator) Weighted(g graph.IGraph) *EdgeWeightedGraph {
+	ewg := NewEdgeWeightedGraphN(g.V())
+	for v := 0; v < g.V(); v++ {
+		selfLoops := 0
+		for _, ww := range g.Adj(v) {
+			w := ww.(int)
+			if w > v {
+				ewg.AddEdge(NewEdge(v, w, gen.weight()))
+			} else if w == v {
+				// a self loop is twice in the adjacency list
+				if selfLoops%2 == 0 {
+					ewg.AddEdge(NewEdge(v, w, gen.weight()))
+				}
+				selfLoops++
+			}
+		}
+	}
+	return ewg
+}
+
+// Returns a random edge-weighted graph with v vertices and e edges,
+// which may have self-loops and parallel edges
+func (gen *EdgeWeightedGraphGenerator) Random(v, e int) *EdgeWeightedGraph {
+	if e < 0 {
+		panic("Number of edges must be non-negative")
+	}
+
+	g := NewEdgeWeightedGraphN(v)
+	for i := 0; i < e; i++ {
+		x, y := gen.r.UniformIntN(v), gen.r.UniformIntN(v)
+		g.AddEdge(NewEdge(x, y, gen.weight()))
+	}
+	return g
+}
+
+func (gen *EdgeWeightedGraphGenerator) weight() float64 {
+	return gen.Lo + (gen.Hi-gen.Lo)*gen.r.Float64()
+}
@@ -1,7 +1,10 @@
 package mst
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/fund/uf"
 	"github.com/youngzhu/algs4-go/sorting/pq"
 )
@@ -20,18 +23,28 @@ type KruskalMST struct {
 }
 
 func NewKruskalMST(g EdgeWeightedGraph) *KruskalMST {
+	mst, _ := NewKruskalMSTContext(context.Background(), g, nil)
+	return mst
+}
+
+// Same as NewKruskalMST, but stops and returns ctx.Err() when ctx is done,
+// see graphs.Tracker. progress may be nil. Kruskal's algorithm processes
+// edges, not vertices: progress is told the number of edges taken off the
+// priority queue.
+func NewKruskalMSTContext(ctx context.Context, g EdgeWeightedGraph, progress graphs.Progress) (*KruskalMST, error) {
 	minPQ := pq.NewMinPQ()
 
 	for _, e := range g.Edges() {
 		minPQ.Insert(e.(*Edge))
 	}
+	t := graphs.NewTracker(ctx, progress)
 
 	mst := fund.NewQueue()
 	weight := 0.0
 
 	// run greedy algorithm
 	unionFind := uf.NewUF(g.V())
-	for !minPQ.IsEmpty() && mst.Size() < g.V()-1 {
+	for !minPQ.IsEmpty() && mst.Size() < g.V()-1 && t.Visit() {
 		e := minPQ.Delete().(*Edge)
 		v := e.Either()
 		w := e.Other(v)
@@ -44,7 +57,11 @@ func NewKruskalMST(g EdgeWeightedGraph) *KruskalMST {
 		}
 	}
 
-	return &KruskalMST{weight, mst}
+	if err := t.Done(); err != nil {
+		return nil, err
+	}
+
+	return &KruskalMST{weight, mst}, nil
 }
 
 // Returns the edges in a MST
@@ -1,7 +1,10 @@
 package mst
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/fund"
+	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/sorting/pq"
 )
 
@@ -26,6 +29,13 @@ type LazyPrimMST struct {
 }
 
 func NewLazyPrimMST(g EdgeWeightedGraph) *LazyPrimMST {
+	lp, _ := NewLazyPrimMSTContext(context.Background(), g, nil)
+	return lp
+}
+
+// Same as NewLazyPrimMST, but stops and returns ctx.Err() when ctx is done,
+// see graphs.Tracker. progress may be nil.
+func NewLazyPrimMSTContext(ctx context.Context, g EdgeWeightedGraph, progress graphs.Progress) (*LazyPrimMST, error) {
 	mst := fund.NewQueue()
 	epq := pq.NewMinPQ()
 	marked := make([]bool, g.V())
@@ -33,21 +43,26 @@ func NewLazyPrimMST(g EdgeWeightedGraph) *LazyPrimMST {
 	lp := &LazyPrimMST{graph: g, mst: mst, marked: marked, epq: epq}
 
 	// run Prim from all vertices to get a minimum spanning forest
-	for v := 0; v < g.V(); v++ {
+	t := graphs.NewTracker(ctx, progress)
+	for v := 0; v < g.V() && t.OK(); v++ {
 		if !lp.marked[v] {
-			lp.prim(v)
+			lp.prim(v, t)
 		}
 	}
+	if err := t.Done(); err != nil {
+		return nil, err
+	}
 
-	return lp
+	return lp, nil
 }
 
-// run Prim's algorithm
-func (lp *LazyPrimMST) prim(s int) {
+// run Prim's algorithm, until t says to stop
+func (lp *LazyPrimMST) prim(s int, t *graphs.Tracker) {
 	lp.scan(s)
+	t.Visit()
 
 	// better to stop when mst has V-1 edges smallest edge on pq
-	for !lp.epq.IsEmpty() {
+	for !lp.epq.IsEmpty() && t.OK() {
 		e := lp.epq.Delete().(*Edge)
 		// two endpoints
 		v := e.Either()
@@ -69,6 +84,7 @@ func (lp *LazyPrimMST) prim(s int) {
 		if !lp.marked[w] {
 			lp.scan(w)
 		}
+		t.Visit() // one more vertex on the tree
 	}
 }
 
@@ -0,0 +1,71 @@
+package graphs
+
+import "context"
+
+// Cancellation.
+// The ...Context constructors of the graph packages stop when their context
+// is done, and return ctx.Err(). Every CheckInterval vertices processed, they
+// check the context and tell an optional Progress function the number of
+// vertices processed so far; they tell it the last number at the end. A
+// vertex is processed when a search takes it off its queue, stack or priority
+// queue. An algorithm that makes several passes (Kosaraju-Sharir) or that
+// can process a vertex several times (Bellman-Ford) counts it each time.
+
+// Progress is told the number of vertices processed so far
+type Progress func(processed int)
+
+// The number of vertices processed between two checks of the context
+const CheckInterval = 1024
+
+// Tracker counts the vertices processed by an algorithm, for its context and
+// its progress function
+type Tracker struct {
+	ctx       context.Context
+	progress  Progress // or nil
+	processed int
+	reported  int // the last number told to progress
+	err       error
+}
+
+// New a tracker of the context ctx and the progress function, which may be nil
+func NewTracker(ctx context.Context, progress Progress) *Tracker {
+	return &Tracker{ctx: ctx, progress: progress, err: ctx.Err()}
+}
+
+// Counts one more vertex processed. Returns false if the context is done:
+// the algorithm must stop.
+func (t *Tracker) Visit() bool {
+	if t.err != nil {
+		return false
+	}
+	t.processed++
+	if t.processed%CheckInterval == 0 {
+		t.report()
+		t.err = t.ctx.Err()
+	}
+	return t.err == nil
+}
+
+// Returns false if the context was done at the last check
+func (t *Tracker) OK() bool {
+	return t.err == nil
+}
+
+// Reports the number of vertices processed, and returns the error of the
+// context if the algorithm stopped because it was done, or nil
+func (t *Tracker) Done() error {
+	t.report()
+	return t.err
+}
+
+// Returns the number of vertices processed so far
+func (t *Tracker) Processed() int {
+	return t.processed
+}
+
+func (t *Tracker) report() {
+	if t.progress != nil && t.processed != t.reported {
+		t.progress(t.processed)
+		t.reported = t.processed
+	}
+}
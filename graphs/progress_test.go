@@ -0,0 +1,40 @@
+package graphs
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTracker(t *testing.T) {
+	var reports []int
+	tr := NewTracker(context.Background(), func(n int) { reports = append(reports, n) })
+	for i := 0; i < 2*CheckInterval+10; i++ {
+		if !tr.Visit() {
+			t.Fatalf("stopped at %d", i)
+		}
+	}
+	if err := tr.Done(); err != nil {
+		t.Fatal(err)
+	}
+	want := []int{CheckInterval, 2 * CheckInterval, 2*CheckInterval + 10}
+	if len(reports) != len(want) || reports[0] != want[0] || reports[1] != want[1] || reports[2] != want[2] {
+		t.Errorf("got reports %v, want %v", reports, want)
+	}
+
+	// canceled at the first check
+	ctx, cancel := context.WithCancel(context.Background())
+	tr = NewTracker(ctx, func(int) { cancel() })
+	i := 0
+	for tr.Visit() {
+		i++
+	}
+	if i != CheckInterval-1 || tr.OK() || tr.Done() != context.Canceled {
+		t.Errorf("stopped after %d vertices, with %v", i+1, tr.Done())
+	}
+
+	// canceled before
+	tr = NewTracker(ctx, nil)
+	if tr.Visit() || tr.Processed() != 0 || tr.Done() != context.Canceled {
+		t.Errorf("not canceled")
+	}
+}
@@ -1,6 +1,8 @@
 package sp
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/graphs/digraph"
 	"github.com/youngzhu/algs4-go/fund"
@@ -22,6 +24,14 @@ type BellmanFordSP struct {
 }
 
 func NewBellmanFordSP(g digraph.EdgeWeightedDigraph, s int) *BellmanFordSP {
+	bf, _ := NewBellmanFordSPContext(context.Background(), g, s, nil)
+	return bf
+}
+
+// Same as NewBellmanFordSP, but stops and returns ctx.Err() when ctx is done,
+// see graphs.Tracker. progress may be nil; a vertex is counted each time it
+// is taken off the queue.
+func NewBellmanFordSPContext(ctx context.Context, g digraph.EdgeWeightedDigraph, s int, progress graphs.Progress) (*BellmanFordSP, error) {
 	n := g.V()
 	distTo := make([]graphs.Distance, n)
 	edgeTo := make([]*digraph.DirectedEdge, n)
@@ -44,13 +54,17 @@ func NewBellmanFordSP(g digraph.EdgeWeightedDigraph, s int) *BellmanFordSP {
 		onQueue: onQueue,
 		queue: queue,}
 
-	for !bf.queue.IsEmpty() && !bf.HasNegativeCycle() {
+	t := graphs.NewTracker(ctx, progress)
+	for !bf.queue.IsEmpty() && !bf.HasNegativeCycle() && t.Visit() {
 		v := queue.Dequeue().(int)
 		onQueue[v] = false
 		bf.relax(v)
 	}
+	if err := t.Done(); err != nil {
+		return nil, err
+	}
 
-	return bf
+	return bf, nil
 }
 
 // relax vertex v and put other endpoints on queue if changed
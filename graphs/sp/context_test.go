@@ -0,0 +1,58 @@
+package sp_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/youngzhu/algs4-go/graphs"
+	"github.com/youngzhu/algs4-go/graphs/digraph"
+	"github.com/youngzhu/algs4-go/graphs/sp"
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func TestContext(t *testing.T) {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+	g := digraph.NewEdgeWeightedDigraphGenerator(r).Random(3000, 9000)
+	want := sp.NewDijkstraSP(*g, 0)
+
+	// the same distances, and progress up to the vertices processed
+	last := 0
+	progress := func(n int) { last = n }
+
+	dijkstra, err := sp.NewDijkstraSPContext(context.Background(), g, 0, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bf, err := sp.NewBellmanFordSPContext(context.Background(), *g, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reached := 0
+	for v := 0; v < g.V(); v++ {
+		if dijkstra.DistTo(v) != want.DistTo(v) || math.Abs(bf.DistTo(v)-want.DistTo(v)) > 1e-9 {
+			t.Fatalf("different paths to %d", v)
+		}
+		if want.HasPathTo(v) {
+			reached++
+		}
+	}
+	if last != reached {
+		t.Errorf("DijkstraSP: progress %d, want %d", last, reached)
+	}
+
+	// canceled at the first report
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := func(n int) {
+		last = n
+		cancel()
+	}
+	if _, err := sp.NewBellmanFordSPContext(ctx, *g, 0, stop); err != context.Canceled || last != graphs.CheckInterval {
+		t.Errorf("BellmanFordSP: %v after %d vertices", err, last)
+	}
+	if _, err := sp.NewDijkstraSPContext(ctx, g, 0, nil); err != context.Canceled {
+		t.Errorf("DijkstraSP: %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package sp
 
 import (
+	"context"
+
 	"github.com/youngzhu/algs4-go/graphs"
 	"github.com/youngzhu/algs4-go/graphs/digraph"
 	"github.com/youngzhu/algs4-go/fund"
@@ -16,27 +18,64 @@ import (
 // Assumes all weights are non-negative.
 
 type DijkstraSP struct {
-	graph digraph.EdgeWeightedDigraph
+	graph digraph.IEdgeWeightedDigraph
 	source int
 	distTo []graphs.Distance // distTo[v]: distance of shortest s->v path
 	edgeTo []*digraph.DirectedEdge // edgeTo[v]: last edge on shortest s->v path
 	ipq *pq.MinIndexPQ // priority queue of vertices
+
+	// The edges of a CompactWeightedAdj digraph are scanned in place: instead
+	// of edgeTo, the last edge on the shortest s->v path is kept as
+	// fromTo[v] and weightTo[v], and PathTo makes its DirectedEdges.
+	fromTo []int
+	weightTo []float64
 }
 
+// Computes a shortest-paths tree from the source vertex s to every other
+// vertex in the edge-weighted digraph g
 func NewDijkstraSP(g digraph.EdgeWeightedDigraph, s int) *DijkstraSP {
-	for _, edge := range g.Edges() {
-		e := edge.(*digraph.DirectedEdge)
-		if e.Weight() < 0 {
-			panic("negative weight")
+	return NewDijkstraSPOf(&g, s)
+}
+
+// Same as NewDijkstraSP, for any IEdgeWeightedDigraph, such as a CSR
+// edge-weighted digraph
+func NewDijkstraSPOf(g digraph.IEdgeWeightedDigraph, s int) *DijkstraSP {
+	sp, _ := NewDijkstraSPContext(context.Background(), g, s, nil)
+	return sp
+}
+
+// Same as NewDijkstraSP, but stops and returns ctx.Err() when ctx is done,
+// see graphs.Tracker. progress may be nil.
+func NewDijkstraSPContext(ctx context.Context, g digraph.IEdgeWeightedDigraph, s int, progress graphs.Progress) (*DijkstraSP, error) {
+	compact, isCompact := g.(digraph.CompactWeightedAdj)
+	for v := 0; v < g.V(); v++ {
+		if isCompact {
+			for _, weight := range compact.Weights(v) {
+				if weight < 0 {
+					panic("negative weight")
+				}
+			}
+			continue
+		}
+		for _, edge := range g.Adj(v) {
+			e := edge.(*digraph.DirectedEdge)
+			if e.Weight() < 0 {
+				panic("negative weight")
+			}
 		}
 	}
 
 	n := g.V()
 	distTo := make([]graphs.Distance, n)
-	edgeTo := make([]*digraph.DirectedEdge, n)
 	ipq := pq.NewMinIndexPQ(n)
 
-	sp := &DijkstraSP{g, s, distTo, edgeTo, ipq}
+	sp := &DijkstraSP{graph: g, source: s, distTo: distTo, ipq: ipq}
+	if isCompact {
+		sp.fromTo = make([]int, n)
+		sp.weightTo = make([]float64, n)
+	} else {
+		sp.edgeTo = make([]*digraph.DirectedEdge, n)
+	}
 
 	sp.validateVertex(s)
 
@@ -48,31 +87,47 @@ func NewDijkstraSP(g digraph.EdgeWeightedDigraph, s int) *DijkstraSP {
 	sp.ipq.Insert(s, distTo[s])
 
 	// relax vertices in order of distance from s
-	for !sp.ipq.IsEmpty() {
+	t := graphs.NewTracker(ctx, progress)
+	for !sp.ipq.IsEmpty() && t.Visit() {
 		v := sp.ipq.Delete()
+		if isCompact {
+			weights := compact.Weights(v)
+			for i, w := range compact.Targets(v) {
+				if sp.relax(v, int(w), weights[i]) {
+					sp.fromTo[w], sp.weightTo[w] = v, weights[i]
+				}
+			}
+			continue
+		}
 		for _, edge := range g.Adj(v) {
 			e := edge.(*digraph.DirectedEdge)
-			sp.relax(e)
+			if sp.relax(e.From(), e.To(), e.Weight()) {
+				sp.edgeTo[e.To()] = e
+			}
 		}
 	}
+	if err := t.Done(); err != nil {
+		return nil, err
+	}
 
-	return sp
+	return sp, nil
 }
 
-// relax edge e and update pq if changed
-func (sp *DijkstraSP) relax(e *digraph.DirectedEdge) {
-	v, w := e.From(), e.To()
-	distance := graphs.Distance(e.Weight())
+// relax edge v->w and update pq if changed.
+// Returns true if the edge is now the last edge on the shortest s->w path.
+func (sp *DijkstraSP) relax(v, w int, weight float64) bool {
+	distance := graphs.Distance(weight)
 	if sp.distTo[w] > sp.distTo[v] + distance {
 		sp.distTo[w] = sp.distTo[v] + distance
-		sp.edgeTo[w] = e
 
 		if sp.ipq.Contains(w) {
 			sp.ipq.Decrease(w, sp.distTo[w])
 		} else {
 			sp.ipq.Insert(w, sp.distTo[w])
 		}
+		return true
 	}
+	return false
 }
 
 // Returns the length of a shortest path from the source vertex s to vertex v
@@ -93,14 +148,24 @@ func (sp DijkstraSP) PathTo(v int) fund.Iterator {
 	stack := fund.NewStack()
 
 	if sp.HasPathTo(v) {
-		for e := sp.edgeTo[v]; e != nil; e = sp.edgeTo[e.From()] {
+		for v != sp.source {
+			e := sp.lastEdge(v)
 			stack.Push(e)
+			v = e.From()
 		}
 	}
 
 	return stack.Iterator()
 }
 
+// the last edge on the shortest s->v path, v != s
+func (sp DijkstraSP) lastEdge(v int) *digraph.DirectedEdge {
+	if sp.edgeTo != nil {
+		return sp.edgeTo[v]
+	}
+	return digraph.NewDirectedEdge(sp.fromTo[v], v, sp.weightTo[v])
+}
+
 func (sp *DijkstraSP) Source() int {
 	return sp.source
 }
@@ -1,7 +1,9 @@
 package sp_test
 
 import (
+	"context"
 	"fmt"
+	"math"
 
 	"github.com/youngzhu/algs4-go/graphs/digraph"
 	"github.com/youngzhu/algs4-go/graphs/sp"
@@ -178,3 +180,43 @@ func ExampleArbitrage() {
 	//  741.00000 EUR = 1012.20600 CAD
 	// 1012.20600 CAD = 1007.14497 USD
 }
+
+func ExampleDijkstraSP_generated() {
+	r := testutil.NewRandom()
+	r.Seed(2022)
+
+	gen := digraph.NewEdgeWeightedDigraphGenerator(r)
+	g := gen.Random(50, 300)
+
+	dijkstra := sp.NewDijkstraSP(*g, 0)
+	bellmanFord := sp.NewBellmanFordSP(*g, 0)
+	same := true
+	for v := 0; v < g.V(); v++ {
+		if math.Abs(dijkstra.DistTo(v)-bellmanFord.DistTo(v)) > 1e-12 {
+			same = false
+		}
+	}
+	fmt.Println(g.E(), same)
+
+	// Output:
+	// 300 true
+}
+
+// a search that is canceled, and one that reports its progress
+func ExampleNewDijkstraSPContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := sp.NewDijkstraSPContext(ctx, tinyEWD, 0, nil)
+	fmt.Println(err)
+
+	progress := func(processed int) {
+		fmt.Println("processed", processed)
+	}
+	dsp, _ := sp.NewDijkstraSPContext(context.Background(), tinyEWD, 0, progress)
+	fmt.Printf("%.2f\n", dsp.DistTo(6))
+
+	// Output:
+	// context canceled
+	// processed 8
+	// 1.51
+}
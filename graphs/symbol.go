@@ -0,0 +1,53 @@
+package graphs
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Symbol graph input.
+// Each line of the input of a symbol graph names a vertex, followed by the
+// names of the vertices adjacent to it, separated by a delimiter. The names
+// may be quoted as in CSV files (RFC 4180), so that they can contain the
+// delimiter: "Washington, D.C.",Boston. Empty lines are skipped.
+
+// the longest line: the lines of movies.txt are long
+const maxLineSize = 1 << 20
+
+// ReadSymbolLines reads the lines of r, split at each delimiter, and calls
+// f with the names on each line
+func ReadSymbolLines(r io.Reader, delimiter string, f func(names []string)) error {
+	if delimiter == "" {
+		return errors.New("empty delimiter")
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			f(strings.Split(line, delimiter))
+		}
+	}
+	return scanner.Err()
+}
+
+// ReadSymbolCSV reads the records of r in CSV format, with the comma as
+// field delimiter, and calls f with the names of each record. The errors
+// give the line of the record.
+func ReadSymbolCSV(r io.Reader, comma rune, f func(names []string)) error {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.FieldsPerRecord = -1 // any number of adjacent vertices
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(record)
+	}
+}
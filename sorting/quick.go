@@ -63,17 +63,19 @@ func quicksort(x Sortable, lo, hi int) {
 // so that x[lo..j-1] <= x[j] <= x[j+1..hi]
 // and return the index j
 func partition(x Sortable, lo, hi int) int {
-	i, j := lo+1, hi
+	i, j := lo, hi+1
 
 	for {
 		// find item on lo to swap
-		for ; x.Less(i, lo); i++ {
+		// both scans move on after a swap, or they would stop again and
+		// again on two items equal to the partitioning item
+		for i++; x.Less(i, lo); i++ {
 			if i == hi {
 				break
 			}
 		}
 		// find item on hi to swap
-		for ; x.Less(lo, j); j-- {
+		for j--; x.Less(lo, j); j-- {
 			if j == lo {
 				break //redundant since x[lo] acts as sentinel
 			}
@@ -35,3 +35,13 @@ func TestQuicksortStrings(t *testing.T) {
 		t.Errorf("    got %v", data)
 	}
 }
+
+// the scans stop on keys equal to the partitioning item
+func TestQuicksortDuplicates(t *testing.T) {
+	data := []int{1, 1, 1, 2, 1, 0, 1, 1}
+	x := IntSortSlice(data)
+	Quicksort(x)
+	if !IsSorted(x) {
+		t.Errorf("got %v", data)
+	}
+}
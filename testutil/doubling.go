@@ -0,0 +1,161 @@
+package testutil
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+)
+
+// Doubling experiments.
+// Generate a sequence of inputs, doubling the input size n at each step, and
+// measure the running time of the program on each one. If T(n) ~ a*n^b, then
+// the ratio T(2n)/T(n) approaches 2^b, and a straight line fitted to the
+// points (lg n, lg T(n)) has slope b. Both give an estimate of the order of
+// growth of the running time without looking at the code.
+
+// Workload is the code under test, run on an input of size n
+type Workload func(n int)
+
+// Generator prepares an input of size n for the next run of the workload.
+// It is not included in the measured time.
+type Generator func(n int)
+
+// Trial is the measured running time (in seconds) for an input size
+type Trial struct {
+	N     int
+	Time  float64
+	Ratio float64 // Time divided by the time of the previous trial (0 for the first one)
+}
+
+// DoublingTest runs a workload on inputs of doubling sizes and measures its
+// running times. The exported fields set up the experiment.
+type DoublingTest struct {
+	workload  Workload
+	generator Generator
+
+	Start   int       // first input size
+	Max     int       // largest input size (0: no limit)
+	MaxTime float64   // stop after a trial that takes longer than this, in seconds (0: no limit)
+	Warmup  int       // untimed runs before the first trial
+	Repeat  int       // timed runs per input size, the median is reported
+	Out     io.Writer // where trials are printed (nil: not printed)
+}
+
+// NewDoublingTest returns a doubling experiment for the workload, starting at
+// n=250, with one timed run per input size, printing to standard output.
+// generator may be nil.
+func NewDoublingTest(workload Workload, generator Generator) *DoublingTest {
+	if workload == nil {
+		panic("workload is nil")
+	}
+
+	return &DoublingTest{
+		workload:  workload,
+		generator: generator,
+		Start:     250,
+		Repeat:    1,
+		Out:       os.Stdout,
+	}
+}
+
+// DoublingTest prints the input size and running time of each trial
+func (d *DoublingTest) DoublingTest() []Trial {
+	return d.run(func(t Trial) {
+		fmt.Fprintf(d.Out, "%7d %7.3f\n", t.N, t.Time)
+	})
+}
+
+// DoublingRatio prints the input size, running time and the ratio to the
+// previous running time of each trial
+func (d *DoublingTest) DoublingRatio() []Trial {
+	return d.run(func(t Trial) {
+		if t.Ratio == 0 {
+			fmt.Fprintf(d.Out, "%7d %7.3f %5s\n", t.N, t.Time, "-")
+		} else {
+			fmt.Fprintf(d.Out, "%7d %7.3f %5.1f\n", t.N, t.Time, t.Ratio)
+		}
+	})
+}
+
+func (d *DoublingTest) run(print func(t Trial)) []Trial {
+	if d.Start <= 0 || d.Repeat <= 0 {
+		panic("Start and Repeat must be positive")
+	}
+
+	for i := 0; i < d.Warmup; i++ {
+		d.timeTrial(d.Start)
+	}
+
+	var trials []Trial
+	for n := d.Start; d.Max <= 0 || n <= d.Max; n += n {
+		times := make([]float64, d.Repeat)
+		for i := range times {
+			times[i] = d.timeTrial(n)
+		}
+		sort.Float64s(times)
+
+		t := Trial{N: n, Time: times[len(times)/2]}
+		if len(trials) > 0 && trials[len(trials)-1].Time > 0 {
+			t.Ratio = t.Time / trials[len(trials)-1].Time
+		}
+		trials = append(trials, t)
+
+		if d.Out != nil {
+			print(t)
+		}
+		if d.MaxTime > 0 && t.Time > d.MaxTime {
+			break
+		}
+	}
+
+	// no fit if the workload is too fast for the clock
+	if a, b, ok := FitPowerLaw(trials); d.Out != nil && ok {
+		fmt.Fprintf(d.Out, "T(n) ~ %.3e * n^%.2f\n", a, b)
+	}
+
+	return trials
+}
+
+// returns the running time of the workload on an input of size n, in seconds
+func (d *DoublingTest) timeTrial(n int) float64 {
+	if d.generator != nil {
+		d.generator(n)
+	}
+
+	start := time.Now()
+	d.workload(n)
+	return time.Since(start).Seconds()
+}
+
+// FitPowerLaw fits T(n) = a * n^b to the trials, by linear least squares
+// regression on lg T(n) = lg a + b * lg n.
+// Trials that took no measurable time are ignored. ok is false if fewer
+// than two input sizes are left, as with a fast workload on a coarse clock.
+func FitPowerLaw(trials []Trial) (a, b float64, ok bool) {
+	var sumX, sumY, sumXX, sumXY float64
+	m := 0
+	sizes := make(map[int]bool)
+	for _, t := range trials {
+		if t.Time <= 0 || t.N <= 0 {
+			continue
+		}
+		sizes[t.N] = true
+		x, y := math.Log2(float64(t.N)), math.Log2(t.Time)
+		sumX += x
+		sumY += y
+		sumXX += x * x
+		sumXY += x * y
+		m++
+	}
+	if len(sizes) < 2 {
+		return 0, 0, false
+	}
+
+	mm := float64(m)
+	b = (mm*sumXY - sumX*sumY) / (mm*sumXX - sumX*sumX)
+	a = math.Exp2((sumY - b*sumX) / mm)
+	return a, b, true
+}
@@ -0,0 +1,94 @@
+package testutil_test
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/youngzhu/algs4-go/testutil"
+)
+
+func ExampleFitPowerLaw() {
+	// running times of a cubic algorithm: T(n) = 2e-9 * n^3
+	var trials []testutil.Trial
+	for n := 250; n <= 8000; n += n {
+		trials = append(trials, testutil.Trial{N: n, Time: 2e-9 * math.Pow(float64(n), 3)})
+	}
+
+	a, b, _ := testutil.FitPowerLaw(trials)
+	fmt.Printf("a = %.1e, b = %.2f\n", a, b)
+
+	// Output:
+	// a = 2.0e-09, b = 3.00
+}
+
+// a workload too fast for the clock: no fit
+func ExampleFitPowerLaw_unmeasurable() {
+	trials := []testutil.Trial{{N: 250, Time: 0}, {N: 500, Time: 0}, {N: 1000, Time: 1e-6}}
+
+	_, _, ok := testutil.FitPowerLaw(trials)
+	fmt.Println(ok)
+
+	// Output:
+	// false
+}
+
+func ExampleDoublingTest_DoublingRatio() {
+	var a []int
+
+	// quadratic workload, with the input generated outside of the timing
+	workload := func(n int) {
+		count := 0
+		for i := range a {
+			for j := i + 1; j < len(a); j++ {
+				if a[i]+a[j] == 0 {
+					count++
+				}
+			}
+		}
+	}
+	generator := func(n int) {
+		a = make([]int, n)
+		for i := range a {
+			a[i] = testutil.UniformIntRange(-1000000, 1000000)
+		}
+	}
+
+	d := testutil.NewDoublingTest(workload, generator)
+	d.Start, d.Max = 1000, 16000
+	d.Warmup, d.Repeat = 1, 3
+	d.DoublingRatio()
+}
+
+// the input sizes of the trials and of the runs: a workload that takes
+// longer than MaxTime ends the experiment
+func ExampleDoublingTest_trials() {
+	var generated, runs []int
+	workload := func(n int) {
+		runs = append(runs, n)
+		if n >= 400 {
+			time.Sleep(2 * time.Millisecond)
+		}
+	}
+	generator := func(n int) {
+		generated = append(generated, n)
+	}
+
+	d := testutil.NewDoublingTest(workload, generator)
+	d.Start, d.Max, d.MaxTime = 100, 3200, 0.001
+	d.Warmup, d.Repeat = 1, 3
+	d.Out = nil
+
+	for _, t := range d.DoublingRatio() {
+		fmt.Println(t.N)
+	}
+	fmt.Println(runs)
+	fmt.Println(len(generated) == len(runs))
+
+	// Output:
+	// 100
+	// 200
+	// 400
+	// [100 100 100 100 200 200 200 400 400 400]
+	// true
+}
@@ -1,6 +1,7 @@
 package testutil_test
 
 import (
+	"compress/gzip"
 	"errors"
 	"fmt"
 
@@ -146,3 +147,13 @@ func ExampleIn_error() {
 	// Output:
 	// true
 }
+
+// a .gz file that is not compressed
+func ExampleNewReader_error() {
+	_, err := testutil.NewReader("testdata/corrupt.gz")
+
+	fmt.Println(errors.Is(err, gzip.ErrHeader))
+
+	// Output:
+	// true
+}
@@ -63,7 +63,13 @@ func NewInReadLines(uri string) *In {
 	return &In{reader: r, scanner: scanner}
 }
 
-func newReader(uri string) (io.Reader, error) {
+// Opens a file, uncompressing it if its name ends with .gz, or a URL.
+// The caller must close the reader.
+func NewReader(uri string) (io.ReadCloser, error) {
+	return newReader(uri)
+}
+
+func newReader(uri string) (io.ReadCloser, error) {
 	if uri == "" {
 		return nil, ErrEmpty
 	}
@@ -74,9 +80,10 @@ func newReader(uri string) (io.Reader, error) {
 		if strings.HasSuffix(uri, ".gz") {
 			gz, err := gzip.NewReader(f)
 			if err != nil {
-				panic(err)
+				f.Close()
+				return nil, err
 			}
-			return gz, nil
+			return gzipFile{gz, f}, nil
 		} else {
 			return f, nil
 		}
@@ -91,6 +98,20 @@ func newReader(uri string) (io.Reader, error) {
 
 }
 
+// gzipFile closes both the gzip reader and the file
+type gzipFile struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g gzipFile) Close() error {
+	err := g.Reader.Close()
+	if ferr := g.f.Close(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
 func (in *In) ReadString() string {
 	in.next()
 	return in.scanner.Text()